@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -42,6 +43,11 @@ type AccessApplicationDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-applications-list-access-applications
 func (api *API) AccessApplications(zoneID string, pageOpts PaginationOptions) ([]AccessApplication, ResultInfo, error) {
+	return api.AccessApplicationsContext(context.TODO(), zoneID, pageOpts)
+}
+
+// AccessApplicationsContext is like AccessApplications but takes a context.
+func (api *API) AccessApplicationsContext(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]AccessApplication, ResultInfo, error) {
 	v := url.Values{}
 	if pageOpts.PerPage > 0 {
 		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
@@ -55,7 +61,7 @@ func (api *API) AccessApplications(zoneID string, pageOpts PaginationOptions) ([
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccessApplication{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -69,18 +75,52 @@ func (api *API) AccessApplications(zoneID string, pageOpts PaginationOptions) ([
 	return accessApplicationListResponse.Result, accessApplicationListResponse.ResultInfo, nil
 }
 
+// AccessApplicationIterator iterates over Access applications. See Paginator.
+type AccessApplicationIterator struct {
+	*Paginator
+}
+
+// Value returns the current Access application.
+func (it *AccessApplicationIterator) Value() AccessApplication {
+	v, _ := it.value().(AccessApplication)
+	return v
+}
+
+// All returns every remaining Access application, fetching up to concurrency
+// pages at a time.
+func (it *AccessApplicationIterator) All(concurrency int) ([]AccessApplication, error) {
+	var applications []AccessApplication
+	if err := it.collect(concurrency, &applications); err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// AccessApplicationsIter returns an iterator over the Access applications of a
+// zone.
+func (api *API) AccessApplicationsIter(ctx context.Context, zoneID string, pageOpts PaginationOptions) *AccessApplicationIterator {
+	return &AccessApplicationIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.AccessApplicationsContext(ctx, zoneID, pageOpts)
+	})}
+}
+
 // AccessApplication returns a single application based on the
 // application ID.
 //
 // API reference: https://api.cloudflare.com/#access-applications-access-applications-details
 func (api *API) AccessApplication(zoneID, applicationID string) (AccessApplication, error) {
+	return api.AccessApplicationContext(context.TODO(), zoneID, applicationID)
+}
+
+// AccessApplicationContext is like AccessApplication but takes a context.
+func (api *API) AccessApplicationContext(ctx context.Context, zoneID, applicationID string) (AccessApplication, error) {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s",
 		zoneID,
 		applicationID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccessApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -98,9 +138,14 @@ func (api *API) AccessApplication(zoneID, applicationID string) (AccessApplicati
 //
 // API reference: https://api.cloudflare.com/#access-applications-create-access-application
 func (api *API) CreateAccessApplication(zoneID string, accessApplication AccessApplication) (AccessApplication, error) {
+	return api.CreateAccessApplicationContext(context.TODO(), zoneID, accessApplication)
+}
+
+// CreateAccessApplicationContext is like CreateAccessApplication but takes a context.
+func (api *API) CreateAccessApplicationContext(ctx context.Context, zoneID string, accessApplication AccessApplication) (AccessApplication, error) {
 	uri := "/zones/" + zoneID + "/access/apps"
 
-	res, err := api.makeRequest("POST", uri, accessApplication)
+	res, err := api.makeRequestContext(ctx, "POST", uri, accessApplication)
 	if err != nil {
 		return AccessApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -118,6 +163,11 @@ func (api *API) CreateAccessApplication(zoneID string, accessApplication AccessA
 //
 // API reference: https://api.cloudflare.com/#access-applications-update-access-application
 func (api *API) UpdateAccessApplication(zoneID string, accessApplication AccessApplication) (AccessApplication, error) {
+	return api.UpdateAccessApplicationContext(context.TODO(), zoneID, accessApplication)
+}
+
+// UpdateAccessApplicationContext is like UpdateAccessApplication but takes a context.
+func (api *API) UpdateAccessApplicationContext(ctx context.Context, zoneID string, accessApplication AccessApplication) (AccessApplication, error) {
 	if accessApplication.ID == "" {
 		return AccessApplication{}, errors.Errorf("access application ID cannot be empty")
 	}
@@ -128,7 +178,7 @@ func (api *API) UpdateAccessApplication(zoneID string, accessApplication AccessA
 		accessApplication.ID,
 	)
 
-	res, err := api.makeRequest("PUT", uri, accessApplication)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, accessApplication)
 	if err != nil {
 		return AccessApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -146,13 +196,18 @@ func (api *API) UpdateAccessApplication(zoneID string, accessApplication AccessA
 //
 // API reference: https://api.cloudflare.com/#access-applications-delete-access-application
 func (api *API) DeleteAccessApplication(zoneID, applicationID string) error {
+	return api.DeleteAccessApplicationContext(context.TODO(), zoneID, applicationID)
+}
+
+// DeleteAccessApplicationContext is like DeleteAccessApplication but takes a context.
+func (api *API) DeleteAccessApplicationContext(ctx context.Context, zoneID, applicationID string) error {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s",
 		zoneID,
 		applicationID,
 	)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -165,13 +220,18 @@ func (api *API) DeleteAccessApplication(zoneID, applicationID string) error {
 //
 // API reference: https://api.cloudflare.com/#access-applications-revoke-access-tokens
 func (api *API) RevokeAccessApplicationTokens(zoneID, applicationID string) error {
+	return api.RevokeAccessApplicationTokensContext(context.TODO(), zoneID, applicationID)
+}
+
+// RevokeAccessApplicationTokensContext is like RevokeAccessApplicationTokens but takes a context.
+func (api *API) RevokeAccessApplicationTokensContext(ctx context.Context, zoneID, applicationID string) error {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s/revoke-tokens",
 		zoneID,
 		applicationID,
 	)
 
-	_, err := api.makeRequest("POST", uri, nil)
+	_, err := api.makeRequestContext(ctx, "POST", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
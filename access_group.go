@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -90,6 +91,11 @@ type AccessGroupDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-groups-list-access-groups
 func (api *API) AccessGroups(accountID string, pageOpts PaginationOptions) ([]AccessGroup, ResultInfo, error) {
+	return api.AccessGroupsContext(context.TODO(), accountID, pageOpts)
+}
+
+// AccessGroupsContext is like AccessGroups but takes a context.
+func (api *API) AccessGroupsContext(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]AccessGroup, ResultInfo, error) {
 	v := url.Values{}
 	if pageOpts.PerPage > 0 {
 		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
@@ -107,7 +113,7 @@ func (api *API) AccessGroups(accountID string, pageOpts PaginationOptions) ([]Ac
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccessGroup{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -121,17 +127,50 @@ func (api *API) AccessGroups(accountID string, pageOpts PaginationOptions) ([]Ac
 	return accessGroupListResponse.Result, accessGroupListResponse.ResultInfo, nil
 }
 
+// AccessGroupIterator iterates over Access groups. See Paginator.
+type AccessGroupIterator struct {
+	*Paginator
+}
+
+// Value returns the current Access group.
+func (it *AccessGroupIterator) Value() AccessGroup {
+	v, _ := it.value().(AccessGroup)
+	return v
+}
+
+// All returns every remaining Access group, fetching up to concurrency pages at
+// a time.
+func (it *AccessGroupIterator) All(concurrency int) ([]AccessGroup, error) {
+	var groups []AccessGroup
+	if err := it.collect(concurrency, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// AccessGroupsIter returns an iterator over the Access groups of an account.
+func (api *API) AccessGroupsIter(ctx context.Context, accountID string, pageOpts PaginationOptions) *AccessGroupIterator {
+	return &AccessGroupIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.AccessGroupsContext(ctx, accountID, pageOpts)
+	})}
+}
+
 // AccessGroup returns a single group based on the group ID.
 //
 // API reference: https://api.cloudflare.com/#access-groups-access-group-details
 func (api *API) AccessGroup(accountID, groupID string) (AccessGroup, error) {
+	return api.AccessGroupContext(context.TODO(), accountID, groupID)
+}
+
+// AccessGroupContext is like AccessGroup but takes a context.
+func (api *API) AccessGroupContext(ctx context.Context, accountID, groupID string) (AccessGroup, error) {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/groups/%s",
 		accountID,
 		groupID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccessGroup{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -149,12 +188,17 @@ func (api *API) AccessGroup(accountID, groupID string) (AccessGroup, error) {
 //
 // API reference: https://api.cloudflare.com/#access-groups-create-access-group
 func (api *API) CreateAccessGroup(accountID string, accessGroup AccessGroup) (AccessGroup, error) {
+	return api.CreateAccessGroupContext(context.TODO(), accountID, accessGroup)
+}
+
+// CreateAccessGroupContext is like CreateAccessGroup but takes a context.
+func (api *API) CreateAccessGroupContext(ctx context.Context, accountID string, accessGroup AccessGroup) (AccessGroup, error) {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/groups",
 		accountID,
 	)
 
-	res, err := api.makeRequest("POST", uri, accessGroup)
+	res, err := api.makeRequestContext(ctx, "POST", uri, accessGroup)
 	if err != nil {
 		return AccessGroup{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -172,6 +216,11 @@ func (api *API) CreateAccessGroup(accountID string, accessGroup AccessGroup) (Ac
 //
 // API reference: https://api.cloudflare.com/#access-groups-update-access-group
 func (api *API) UpdateAccessGroup(accountID string, accessGroup AccessGroup) (AccessGroup, error) {
+	return api.UpdateAccessGroupContext(context.TODO(), accountID, accessGroup)
+}
+
+// UpdateAccessGroupContext is like UpdateAccessGroup but takes a context.
+func (api *API) UpdateAccessGroupContext(ctx context.Context, accountID string, accessGroup AccessGroup) (AccessGroup, error) {
 	if accessGroup.ID == "" {
 		return AccessGroup{}, errors.Errorf("access group ID cannot be empty")
 	}
@@ -181,7 +230,7 @@ func (api *API) UpdateAccessGroup(accountID string, accessGroup AccessGroup) (Ac
 		accessGroup.ID,
 	)
 
-	res, err := api.makeRequest("PUT", uri, accessGroup)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, accessGroup)
 	if err != nil {
 		return AccessGroup{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -199,13 +248,18 @@ func (api *API) UpdateAccessGroup(accountID string, accessGroup AccessGroup) (Ac
 //
 // API reference: https://api.cloudflare.com/#access-groups-delete-access-group
 func (api *API) DeleteAccessGroup(accountID, groupID string) error {
+	return api.DeleteAccessGroupContext(context.TODO(), accountID, groupID)
+}
+
+// DeleteAccessGroupContext is like DeleteAccessGroup but takes a context.
+func (api *API) DeleteAccessGroupContext(ctx context.Context, accountID, groupID string) error {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/groups/%s",
 		accountID,
 		groupID,
 	)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
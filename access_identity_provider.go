@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -200,10 +201,11 @@ type AccessADSAMLConfiguration struct {
 // AccessIdentityProvidersListResponse is the API response for multiple
 // Access Identity Providers.
 type AccessIdentityProvidersListResponse struct {
-	Success  bool                     `json:"success"`
-	Errors   []string                 `json:"errors"`
-	Messages []string                 `json:"messages"`
-	Result   []AccessIdentityProvider `json:"result"`
+	Success    bool                     `json:"success"`
+	Errors     []string                 `json:"errors"`
+	Messages   []string                 `json:"messages"`
+	Result     []AccessIdentityProvider `json:"result"`
+	ResultInfo `json:"result_info"`
 }
 
 // AccessIdentityProviderListResponse is the API response for a single
@@ -220,20 +222,62 @@ type AccessIdentityProviderListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-identity-providers-list-access-identity-providers
 func (api *API) AccessIdentityProviders(accountID string) ([]AccessIdentityProvider, error) {
-	uri := "/accounts/" + accountID + "/access/identity_providers"
+	return api.AccessIdentityProvidersContext(context.TODO(), accountID)
+}
+
+// AccessIdentityProvidersContext is like AccessIdentityProviders but takes a context.
+func (api *API) AccessIdentityProvidersContext(ctx context.Context, accountID string) ([]AccessIdentityProvider, error) {
+	providers, _, err := api.accessIdentityProviders(ctx, accountID, PaginationOptions{})
+	return providers, err
+}
+
+// accessIdentityProviders fetches a page of the Access Identity Providers for
+// an account.
+func (api *API) accessIdentityProviders(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]AccessIdentityProvider, ResultInfo, error) {
+	uri := "/accounts/" + accountID + "/access/identity_providers" + paginationQuery(pageOpts)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return []AccessIdentityProvider{}, errors.Wrap(err, errMakeRequestError)
+		return []AccessIdentityProvider{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var accessIdentityProviderResponse AccessIdentityProvidersListResponse
 	err = json.Unmarshal(res, &accessIdentityProviderResponse)
 	if err != nil {
-		return []AccessIdentityProvider{}, errors.Wrap(err, errUnmarshalError)
+		return []AccessIdentityProvider{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return accessIdentityProviderResponse.Result, nil
+	return accessIdentityProviderResponse.Result, accessIdentityProviderResponse.ResultInfo, nil
+}
+
+// AccessIdentityProviderIterator iterates over Access Identity Providers. See
+// Paginator.
+type AccessIdentityProviderIterator struct {
+	*Paginator
+}
+
+// Value returns the current Access Identity Provider.
+func (it *AccessIdentityProviderIterator) Value() AccessIdentityProvider {
+	v, _ := it.value().(AccessIdentityProvider)
+	return v
+}
+
+// All returns every remaining Access Identity Provider, fetching up to
+// concurrency pages at a time.
+func (it *AccessIdentityProviderIterator) All(concurrency int) ([]AccessIdentityProvider, error) {
+	var providers []AccessIdentityProvider
+	if err := it.collect(concurrency, &providers); err != nil {
+		return nil, err
+	}
+	return providers, nil
+}
+
+// AccessIdentityProvidersIter returns an iterator over the Access Identity
+// Providers of an account.
+func (api *API) AccessIdentityProvidersIter(ctx context.Context, accountID string, pageOpts PaginationOptions) *AccessIdentityProviderIterator {
+	return &AccessIdentityProviderIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.accessIdentityProviders(ctx, accountID, pageOpts)
+	})}
 }
 
 // AccessIdentityProviderDetails returns a single Access Identity
@@ -241,13 +285,18 @@ func (api *API) AccessIdentityProviders(accountID string) ([]AccessIdentityProvi
 //
 // API reference: https://api.cloudflare.com/#access-identity-providers-access-identity-providers-details
 func (api *API) AccessIdentityProviderDetails(accountID, identityProviderID string) (AccessIdentityProvider, error) {
+	return api.AccessIdentityProviderDetailsContext(context.TODO(), accountID, identityProviderID)
+}
+
+// AccessIdentityProviderDetailsContext is like AccessIdentityProviderDetails but takes a context.
+func (api *API) AccessIdentityProviderDetailsContext(ctx context.Context, accountID, identityProviderID string) (AccessIdentityProvider, error) {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/identity_providers/%s",
 		accountID,
 		identityProviderID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccessIdentityProvider{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -265,9 +314,14 @@ func (api *API) AccessIdentityProviderDetails(accountID, identityProviderID stri
 //
 // API reference: https://api.cloudflare.com/#access-identity-providers-create-access-identity-provider
 func (api *API) CreateAccessIdentityProvider(accountID string, identityProviderConfiguration AccessIdentityProvider) (AccessIdentityProvider, error) {
+	return api.CreateAccessIdentityProviderContext(context.TODO(), accountID, identityProviderConfiguration)
+}
+
+// CreateAccessIdentityProviderContext is like CreateAccessIdentityProvider but takes a context.
+func (api *API) CreateAccessIdentityProviderContext(ctx context.Context, accountID string, identityProviderConfiguration AccessIdentityProvider) (AccessIdentityProvider, error) {
 	uri := "/accounts/" + accountID + "/access/identity_providers"
 
-	res, err := api.makeRequest("POST", uri, identityProviderConfiguration)
+	res, err := api.makeRequestContext(ctx, "POST", uri, identityProviderConfiguration)
 	if err != nil {
 		return AccessIdentityProvider{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -286,13 +340,18 @@ func (api *API) CreateAccessIdentityProvider(accountID string, identityProviderC
 //
 // API reference: https://api.cloudflare.com/#access-identity-providers-create-access-identity-provider
 func (api *API) UpdateAccessIdentityProvider(accountID, identityProviderUUID string, identityProviderConfiguration AccessIdentityProvider) (AccessIdentityProvider, error) {
+	return api.UpdateAccessIdentityProviderContext(context.TODO(), accountID, identityProviderUUID, identityProviderConfiguration)
+}
+
+// UpdateAccessIdentityProviderContext is like UpdateAccessIdentityProvider but takes a context.
+func (api *API) UpdateAccessIdentityProviderContext(ctx context.Context, accountID, identityProviderUUID string, identityProviderConfiguration AccessIdentityProvider) (AccessIdentityProvider, error) {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/identity_providers/%s",
 		accountID,
 		identityProviderUUID,
 	)
 
-	res, err := api.makeRequest("PUT", uri, identityProviderConfiguration)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, identityProviderConfiguration)
 	if err != nil {
 		return AccessIdentityProvider{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -310,13 +369,18 @@ func (api *API) UpdateAccessIdentityProvider(accountID, identityProviderUUID str
 //
 // API reference: https://api.cloudflare.com/#access-identity-providers-create-access-identity-provider
 func (api *API) DeleteAccessIdentityProvider(accountID, identityProviderUUID string) (AccessIdentityProvider, error) {
+	return api.DeleteAccessIdentityProviderContext(context.TODO(), accountID, identityProviderUUID)
+}
+
+// DeleteAccessIdentityProviderContext is like DeleteAccessIdentityProvider but takes a context.
+func (api *API) DeleteAccessIdentityProviderContext(ctx context.Context, accountID, identityProviderUUID string) (AccessIdentityProvider, error) {
 	uri := fmt.Sprintf(
 		"/accounts/%s/access/identity_providers/%s",
 		accountID,
 		identityProviderUUID,
 	)
 
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return AccessIdentityProvider{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -53,6 +54,34 @@ func TestAccessIdentityProviders(t *testing.T) {
 	}
 }
 
+func TestAccessIdentityProvidersIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "provider-%s", "type": "github"}],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2}
+		}
+		`, r.URL.Query().Get("page"), r.URL.Query().Get("page"))
+	}
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/identity_providers", handler)
+
+	it := client.AccessIdentityProvidersIter(context.Background(), "01a7362d577a6c3019a474fd6f485823", PaginationOptions{})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"provider-1", "provider-2"}, ids)
+}
+
 func TestAccessIdentityProviderDetails(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -44,9 +45,14 @@ type AccessOrganizationDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-organizations-access-organization-details
 func (api *API) AccessOrganization(accountID string) (AccessOrganization, ResultInfo, error) {
+	return api.AccessOrganizationContext(context.TODO(), accountID)
+}
+
+// AccessOrganizationContext is like AccessOrganization but takes a context.
+func (api *API) AccessOrganizationContext(ctx context.Context, accountID string) (AccessOrganization, ResultInfo, error) {
 	uri := "/accounts/" + accountID + "/access/organizations"
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccessOrganization{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -64,9 +70,14 @@ func (api *API) AccessOrganization(accountID string) (AccessOrganization, Result
 //
 // API reference: https://api.cloudflare.com/#access-organizations-create-access-organization
 func (api *API) CreateAccessOrganization(accountID string, accessOrganization AccessOrganization) (AccessOrganization, error) {
+	return api.CreateAccessOrganizationContext(context.TODO(), accountID, accessOrganization)
+}
+
+// CreateAccessOrganizationContext is like CreateAccessOrganization but takes a context.
+func (api *API) CreateAccessOrganizationContext(ctx context.Context, accountID string, accessOrganization AccessOrganization) (AccessOrganization, error) {
 	uri := "/accounts/" + accountID + "/access/organizations"
 
-	res, err := api.makeRequest("POST", uri, accessOrganization)
+	res, err := api.makeRequestContext(ctx, "POST", uri, accessOrganization)
 	if err != nil {
 		return AccessOrganization{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -84,9 +95,14 @@ func (api *API) CreateAccessOrganization(accountID string, accessOrganization Ac
 //
 // API reference: https://api.cloudflare.com/#access-organizations-update-access-organization
 func (api *API) UpdateAccessOrganization(accountID string, accessOrganization AccessOrganization) (AccessOrganization, error) {
+	return api.UpdateAccessOrganizationContext(context.TODO(), accountID, accessOrganization)
+}
+
+// UpdateAccessOrganizationContext is like UpdateAccessOrganization but takes a context.
+func (api *API) UpdateAccessOrganizationContext(ctx context.Context, accountID string, accessOrganization AccessOrganization) (AccessOrganization, error) {
 	uri := "/accounts/" + accountID + "/access/organizations"
 
-	res, err := api.makeRequest("PUT", uri, accessOrganization)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, accessOrganization)
 	if err != nil {
 		return AccessOrganization{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -92,6 +93,11 @@ type AccessPolicyDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-policy-list-access-policies
 func (api *API) AccessPolicies(zoneID, applicationID string, pageOpts PaginationOptions) ([]AccessPolicy, ResultInfo, error) {
+	return api.AccessPoliciesContext(context.TODO(), zoneID, applicationID, pageOpts)
+}
+
+// AccessPoliciesContext is like AccessPolicies but takes a context.
+func (api *API) AccessPoliciesContext(ctx context.Context, zoneID, applicationID string, pageOpts PaginationOptions) ([]AccessPolicy, ResultInfo, error) {
 	v := url.Values{}
 	if pageOpts.PerPage > 0 {
 		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
@@ -110,7 +116,7 @@ func (api *API) AccessPolicies(zoneID, applicationID string, pageOpts Pagination
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccessPolicy{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -124,10 +130,44 @@ func (api *API) AccessPolicies(zoneID, applicationID string, pageOpts Pagination
 	return accessPolicyListResponse.Result, accessPolicyListResponse.ResultInfo, nil
 }
 
+// AccessPolicyIterator iterates over Access policies. See Paginator.
+type AccessPolicyIterator struct {
+	*Paginator
+}
+
+// Value returns the current Access policy.
+func (it *AccessPolicyIterator) Value() AccessPolicy {
+	v, _ := it.value().(AccessPolicy)
+	return v
+}
+
+// All returns every remaining Access policy, fetching up to concurrency pages
+// at a time.
+func (it *AccessPolicyIterator) All(concurrency int) ([]AccessPolicy, error) {
+	var policies []AccessPolicy
+	if err := it.collect(concurrency, &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// AccessPoliciesIter returns an iterator over the Access policies of an
+// application.
+func (api *API) AccessPoliciesIter(ctx context.Context, zoneID, applicationID string, pageOpts PaginationOptions) *AccessPolicyIterator {
+	return &AccessPolicyIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.AccessPoliciesContext(ctx, zoneID, applicationID, pageOpts)
+	})}
+}
+
 // AccessPolicy returns a single policy based on the policy ID.
 //
 // API reference: https://api.cloudflare.com/#access-policy-access-policy-details
 func (api *API) AccessPolicy(zoneID, applicationID, policyID string) (AccessPolicy, error) {
+	return api.AccessPolicyContext(context.TODO(), zoneID, applicationID, policyID)
+}
+
+// AccessPolicyContext is like AccessPolicy but takes a context.
+func (api *API) AccessPolicyContext(ctx context.Context, zoneID, applicationID, policyID string) (AccessPolicy, error) {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s/policies/%s",
 		zoneID,
@@ -135,7 +175,7 @@ func (api *API) AccessPolicy(zoneID, applicationID, policyID string) (AccessPoli
 		policyID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccessPolicy{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -153,13 +193,18 @@ func (api *API) AccessPolicy(zoneID, applicationID, policyID string) (AccessPoli
 //
 // API reference: https://api.cloudflare.com/#access-policy-create-access-policy
 func (api *API) CreateAccessPolicy(zoneID, applicationID string, accessPolicy AccessPolicy) (AccessPolicy, error) {
+	return api.CreateAccessPolicyContext(context.TODO(), zoneID, applicationID, accessPolicy)
+}
+
+// CreateAccessPolicyContext is like CreateAccessPolicy but takes a context.
+func (api *API) CreateAccessPolicyContext(ctx context.Context, zoneID, applicationID string, accessPolicy AccessPolicy) (AccessPolicy, error) {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s/policies",
 		zoneID,
 		applicationID,
 	)
 
-	res, err := api.makeRequest("POST", uri, accessPolicy)
+	res, err := api.makeRequestContext(ctx, "POST", uri, accessPolicy)
 	if err != nil {
 		return AccessPolicy{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -177,6 +222,11 @@ func (api *API) CreateAccessPolicy(zoneID, applicationID string, accessPolicy Ac
 //
 // API reference: https://api.cloudflare.com/#access-policy-update-access-policy
 func (api *API) UpdateAccessPolicy(zoneID, applicationID string, accessPolicy AccessPolicy) (AccessPolicy, error) {
+	return api.UpdateAccessPolicyContext(context.TODO(), zoneID, applicationID, accessPolicy)
+}
+
+// UpdateAccessPolicyContext is like UpdateAccessPolicy but takes a context.
+func (api *API) UpdateAccessPolicyContext(ctx context.Context, zoneID, applicationID string, accessPolicy AccessPolicy) (AccessPolicy, error) {
 	if accessPolicy.ID == "" {
 		return AccessPolicy{}, errors.Errorf("access policy ID cannot be empty")
 	}
@@ -187,7 +237,7 @@ func (api *API) UpdateAccessPolicy(zoneID, applicationID string, accessPolicy Ac
 		accessPolicy.ID,
 	)
 
-	res, err := api.makeRequest("PUT", uri, accessPolicy)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, accessPolicy)
 	if err != nil {
 		return AccessPolicy{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -205,6 +255,11 @@ func (api *API) UpdateAccessPolicy(zoneID, applicationID string, accessPolicy Ac
 //
 // API reference: https://api.cloudflare.com/#access-policy-update-access-policy
 func (api *API) DeleteAccessPolicy(zoneID, applicationID, accessPolicyID string) error {
+	return api.DeleteAccessPolicyContext(context.TODO(), zoneID, applicationID, accessPolicyID)
+}
+
+// DeleteAccessPolicyContext is like DeleteAccessPolicy but takes a context.
+func (api *API) DeleteAccessPolicyContext(ctx context.Context, zoneID, applicationID, accessPolicyID string) error {
 	uri := fmt.Sprintf(
 		"/zones/%s/access/apps/%s/policies/%s",
 		zoneID,
@@ -212,7 +267,7 @@ func (api *API) DeleteAccessPolicy(zoneID, applicationID, accessPolicyID string)
 		accessPolicyID,
 	)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -80,9 +81,20 @@ type AccessServiceTokensUpdateDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#access-service-tokens-list-access-service-tokens
 func (api *API) AccessServiceTokens(accountID string) ([]AccessServiceToken, ResultInfo, error) {
-	uri := "/accounts/" + accountID + "/access/service_tokens"
+	return api.AccessServiceTokensContext(context.TODO(), accountID)
+}
+
+// AccessServiceTokensContext is like AccessServiceTokens but takes a context.
+func (api *API) AccessServiceTokensContext(ctx context.Context, accountID string) ([]AccessServiceToken, ResultInfo, error) {
+	return api.accessServiceTokens(ctx, accountID, PaginationOptions{})
+}
+
+// accessServiceTokens fetches a page of the Access Service Tokens for an
+// account.
+func (api *API) accessServiceTokens(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]AccessServiceToken, ResultInfo, error) {
+	uri := "/accounts/" + accountID + "/access/service_tokens" + paginationQuery(pageOpts)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccessServiceToken{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -96,16 +108,51 @@ func (api *API) AccessServiceTokens(accountID string) ([]AccessServiceToken, Res
 	return accessServiceTokensListResponse.Result, accessServiceTokensListResponse.ResultInfo, nil
 }
 
+// AccessServiceTokenIterator iterates over Access Service Tokens. See
+// Paginator.
+type AccessServiceTokenIterator struct {
+	*Paginator
+}
+
+// Value returns the current Access Service Token.
+func (it *AccessServiceTokenIterator) Value() AccessServiceToken {
+	v, _ := it.value().(AccessServiceToken)
+	return v
+}
+
+// All returns every remaining Access Service Token, fetching up to
+// concurrency pages at a time.
+func (it *AccessServiceTokenIterator) All(concurrency int) ([]AccessServiceToken, error) {
+	var tokens []AccessServiceToken
+	if err := it.collect(concurrency, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// AccessServiceTokensIter returns an iterator over the Access Service Tokens
+// of an account.
+func (api *API) AccessServiceTokensIter(ctx context.Context, accountID string, pageOpts PaginationOptions) *AccessServiceTokenIterator {
+	return &AccessServiceTokenIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.accessServiceTokens(ctx, accountID, pageOpts)
+	})}
+}
+
 // CreateAccessServiceToken creates a new Access Service Token for an account.
 //
 // API reference: https://api.cloudflare.com/#access-service-tokens-create-access-service-token
 func (api *API) CreateAccessServiceToken(accountID, name string) (AccessServiceTokenCreateResponse, error) {
+	return api.CreateAccessServiceTokenContext(context.TODO(), accountID, name)
+}
+
+// CreateAccessServiceTokenContext is like CreateAccessServiceToken but takes a context.
+func (api *API) CreateAccessServiceTokenContext(ctx context.Context, accountID, name string) (AccessServiceTokenCreateResponse, error) {
 	uri := "/accounts/" + accountID + "/access/service_tokens"
 	marshalledName, _ := json.Marshal(struct {
 		Name string `json:"name"`
 	}{name})
 
-	res, err := api.makeRequest("POST", uri, marshalledName)
+	res, err := api.makeRequestContext(ctx, "POST", uri, marshalledName)
 
 	if err != nil {
 		return AccessServiceTokenCreateResponse{}, errors.Wrap(err, errMakeRequestError)
@@ -125,13 +172,18 @@ func (api *API) CreateAccessServiceToken(accountID, name string) (AccessServiceT
 //
 // API reference: https://api.cloudflare.com/#access-service-tokens-update-access-service-token
 func (api *API) UpdateAccessServiceToken(accountID, uuid, name string) (AccessServiceTokenUpdateResponse, error) {
+	return api.UpdateAccessServiceTokenContext(context.TODO(), accountID, uuid, name)
+}
+
+// UpdateAccessServiceTokenContext is like UpdateAccessServiceToken but takes a context.
+func (api *API) UpdateAccessServiceTokenContext(ctx context.Context, accountID, uuid, name string) (AccessServiceTokenUpdateResponse, error) {
 	uri := fmt.Sprintf("/accounts/%s/access/service_tokens/%s", accountID, uuid)
 
 	marshalledName, _ := json.Marshal(struct {
 		Name string `json:"name"`
 	}{name})
 
-	res, err := api.makeRequest("PUT", uri, marshalledName)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, marshalledName)
 	if err != nil {
 		return AccessServiceTokenUpdateResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -150,9 +202,14 @@ func (api *API) UpdateAccessServiceToken(accountID, uuid, name string) (AccessSe
 //
 // API reference: https://api.cloudflare.com/#access-service-tokens-delete-access-service-token
 func (api *API) DeleteAccessServiceToken(accountID, uuid string) (AccessServiceTokenUpdateResponse, error) {
+	return api.DeleteAccessServiceTokenContext(context.TODO(), accountID, uuid)
+}
+
+// DeleteAccessServiceTokenContext is like DeleteAccessServiceToken but takes a context.
+func (api *API) DeleteAccessServiceTokenContext(ctx context.Context, accountID, uuid string) (AccessServiceTokenUpdateResponse, error) {
 	uri := fmt.Sprintf("/accounts/%s/access/service_tokens/%s", accountID, uuid)
 
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return AccessServiceTokenUpdateResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -52,6 +53,34 @@ func TestAccessServiceTokens(t *testing.T) {
 	}
 }
 
+func TestAccessServiceTokensIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "token-%s-a"}, {"id": "token-%s-b"}],
+			"result_info": {"page": %s, "per_page": 2, "count": 2, "total_count": 4}
+		}
+		`, page, page, page)
+	}
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens", handler)
+
+	tokens, err := client.AccessServiceTokensIter(context.Background(), "01a7362d577a6c3019a474fd6f485823", PaginationOptions{PerPage: 2}).All(1)
+	if assert.NoError(t, err) && assert.Len(t, tokens, 4) {
+		assert.Equal(t, "token-1-a", tokens[0].ID)
+		assert.Equal(t, "token-2-b", tokens[3].ID)
+	}
+}
+
 func TestCreateAccessServiceToken(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -56,6 +57,11 @@ type AccountMemberInvitation struct {
 //
 // API reference: https://api.cloudflare.com/#accounts-list-accounts
 func (api *API) AccountMembers(accountID string, pageOpts PaginationOptions) ([]AccountMember, ResultInfo, error) {
+	return api.AccountMembersContext(context.TODO(), accountID, pageOpts)
+}
+
+// AccountMembersContext is like AccountMembers but takes a context.
+func (api *API) AccountMembersContext(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]AccountMember, ResultInfo, error) {
 	if accountID == "" {
 		return []AccountMember{}, ResultInfo{}, errors.New(errMissingAccountID)
 	}
@@ -73,7 +79,7 @@ func (api *API) AccountMembers(accountID string, pageOpts PaginationOptions) ([]
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccountMember{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -87,10 +93,43 @@ func (api *API) AccountMembers(accountID string, pageOpts PaginationOptions) ([]
 	return accountMemberListresponse.Result, accountMemberListresponse.ResultInfo, nil
 }
 
+// AccountMemberIterator iterates over account members. See Paginator.
+type AccountMemberIterator struct {
+	*Paginator
+}
+
+// Value returns the current account member.
+func (it *AccountMemberIterator) Value() AccountMember {
+	v, _ := it.value().(AccountMember)
+	return v
+}
+
+// All returns every remaining account member, fetching up to concurrency pages
+// at a time.
+func (it *AccountMemberIterator) All(concurrency int) ([]AccountMember, error) {
+	var members []AccountMember
+	if err := it.collect(concurrency, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// AccountMembersIter returns an iterator over the members of an account.
+func (api *API) AccountMembersIter(ctx context.Context, accountID string, pageOpts PaginationOptions) *AccountMemberIterator {
+	return &AccountMemberIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.AccountMembersContext(ctx, accountID, pageOpts)
+	})}
+}
+
 // CreateAccountMember invites a new member to join an account.
 //
 // API reference: https://api.cloudflare.com/#account-members-add-member
 func (api *API) CreateAccountMember(accountID string, emailAddress string, roles []string) (AccountMember, error) {
+	return api.CreateAccountMemberContext(context.TODO(), accountID, emailAddress, roles)
+}
+
+// CreateAccountMemberContext is like CreateAccountMember but takes a context.
+func (api *API) CreateAccountMemberContext(ctx context.Context, accountID string, emailAddress string, roles []string) (AccountMember, error) {
 	if accountID == "" {
 		return AccountMember{}, errors.New(errMissingAccountID)
 	}
@@ -101,7 +140,7 @@ func (api *API) CreateAccountMember(accountID string, emailAddress string, roles
 		Email: emailAddress,
 		Roles: roles,
 	}
-	res, err := api.makeRequest("POST", uri, newMember)
+	res, err := api.makeRequestContext(ctx, "POST", uri, newMember)
 	if err != nil {
 		return AccountMember{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -119,13 +158,18 @@ func (api *API) CreateAccountMember(accountID string, emailAddress string, roles
 //
 // API reference: https://api.cloudflare.com/#account-members-remove-member
 func (api *API) DeleteAccountMember(accountID string, userID string) error {
+	return api.DeleteAccountMemberContext(context.TODO(), accountID, userID)
+}
+
+// DeleteAccountMemberContext is like DeleteAccountMember but takes a context.
+func (api *API) DeleteAccountMemberContext(ctx context.Context, accountID string, userID string) error {
 	if accountID == "" {
 		return errors.New(errMissingAccountID)
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/members/%s", accountID, userID)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -137,13 +181,18 @@ func (api *API) DeleteAccountMember(accountID string, userID string) error {
 //
 // API reference: https://api.cloudflare.com/#account-members-update-member
 func (api *API) UpdateAccountMember(accountID string, userID string, member AccountMember) (AccountMember, error) {
+	return api.UpdateAccountMemberContext(context.TODO(), accountID, userID, member)
+}
+
+// UpdateAccountMemberContext is like UpdateAccountMember but takes a context.
+func (api *API) UpdateAccountMemberContext(ctx context.Context, accountID string, userID string, member AccountMember) (AccountMember, error) {
 	if accountID == "" {
 		return AccountMember{}, errors.New(errMissingAccountID)
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/members/%s", accountID, userID)
 
-	res, err := api.makeRequest("PUT", uri, member)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, member)
 	if err != nil {
 		return AccountMember{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -161,6 +210,11 @@ func (api *API) UpdateAccountMember(accountID string, userID string, member Acco
 //
 // API reference: https://api.cloudflare.com/#account-members-member-details
 func (api *API) AccountMember(accountID string, memberID string) (AccountMember, error) {
+	return api.AccountMemberContext(context.TODO(), accountID, memberID)
+}
+
+// AccountMemberContext is like AccountMember but takes a context.
+func (api *API) AccountMemberContext(ctx context.Context, accountID string, memberID string) (AccountMember, error) {
 	if accountID == "" {
 		return AccountMember{}, errors.New(errMissingAccountID)
 	}
@@ -171,7 +225,7 @@ func (api *API) AccountMember(accountID string, memberID string) (AccountMember,
 		memberID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccountMember{}, errors.Wrap(err, errMakeRequestError)
 	}
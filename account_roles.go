@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -43,9 +44,14 @@ type AccountRoleDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#account-roles-list-roles
 func (api *API) AccountRoles(accountID string) ([]AccountRole, error) {
+	return api.AccountRolesContext(context.TODO(), accountID)
+}
+
+// AccountRolesContext is like AccountRoles but takes a context.
+func (api *API) AccountRolesContext(ctx context.Context, accountID string) ([]AccountRole, error) {
 	uri := "/accounts/" + accountID + "/roles"
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []AccountRole{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -63,9 +69,14 @@ func (api *API) AccountRoles(accountID string) ([]AccountRole, error) {
 //
 // API reference: https://api.cloudflare.com/#account-roles-role-details
 func (api *API) AccountRole(accountID string, roleID string) (AccountRole, error) {
+	return api.AccountRoleContext(context.TODO(), accountID, roleID)
+}
+
+// AccountRoleContext is like AccountRole but takes a context.
+func (api *API) AccountRoleContext(ctx context.Context, accountID string, roleID string) (AccountRole, error) {
 	uri := fmt.Sprintf("/accounts/%s/roles/%s", accountID, roleID)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AccountRole{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -47,6 +48,11 @@ type AccountDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#accounts-list-accounts
 func (api *API) Accounts(pageOpts PaginationOptions) ([]Account, ResultInfo, error) {
+	return api.AccountsContext(context.TODO(), pageOpts)
+}
+
+// AccountsContext is like Accounts but takes a context.
+func (api *API) AccountsContext(ctx context.Context, pageOpts PaginationOptions) ([]Account, ResultInfo, error) {
 	v := url.Values{}
 	if pageOpts.PerPage > 0 {
 		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
@@ -60,7 +66,7 @@ func (api *API) Accounts(pageOpts PaginationOptions) ([]Account, ResultInfo, err
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []Account{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -73,13 +79,47 @@ func (api *API) Accounts(pageOpts PaginationOptions) ([]Account, ResultInfo, err
 	return accListResponse.Result, accListResponse.ResultInfo, nil
 }
 
+// AccountIterator iterates over accounts. See Paginator.
+type AccountIterator struct {
+	*Paginator
+}
+
+// Value returns the current account.
+func (it *AccountIterator) Value() Account {
+	v, _ := it.value().(Account)
+	return v
+}
+
+// All returns every remaining account, fetching up to concurrency pages at a
+// time.
+func (it *AccountIterator) All(concurrency int) ([]Account, error) {
+	var accounts []Account
+	if err := it.collect(concurrency, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// AccountsIter returns an iterator over the accounts the logged in user has
+// access to.
+func (api *API) AccountsIter(ctx context.Context, pageOpts PaginationOptions) *AccountIterator {
+	return &AccountIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.AccountsContext(ctx, pageOpts)
+	})}
+}
+
 // Account returns a single account based on the ID.
 //
 // API reference: https://api.cloudflare.com/#accounts-account-details
 func (api *API) Account(accountID string) (Account, ResultInfo, error) {
+	return api.AccountContext(context.TODO(), accountID)
+}
+
+// AccountContext is like Account but takes a context.
+func (api *API) AccountContext(ctx context.Context, accountID string) (Account, ResultInfo, error) {
 	uri := "/accounts/" + accountID
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return Account{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -97,9 +137,14 @@ func (api *API) Account(accountID string) (Account, ResultInfo, error) {
 //
 // API reference: https://api.cloudflare.com/#accounts-update-account
 func (api *API) UpdateAccount(accountID string, account Account) (Account, error) {
+	return api.UpdateAccountContext(context.TODO(), accountID, account)
+}
+
+// UpdateAccountContext is like UpdateAccount but takes a context.
+func (api *API) UpdateAccountContext(ctx context.Context, accountID string, account Account) (Account, error) {
 	uri := "/accounts/" + accountID
 
-	res, err := api.makeRequest("PUT", uri, account)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, account)
 	if err != nil {
 		return Account{}, errors.Wrap(err, errMakeRequestError)
 	}
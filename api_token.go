@@ -0,0 +1,343 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// APIToken is a user API token, with the policies that determine what it
+// can access.
+type APIToken struct {
+	ID         string             `json:"id,omitempty"`
+	Name       string             `json:"name,omitempty"`
+	Status     string             `json:"status,omitempty"`
+	IssuedOn   *time.Time         `json:"issued_on,omitempty"`
+	ModifiedOn *time.Time         `json:"modified_on,omitempty"`
+	NotBefore  *time.Time         `json:"not_before,omitempty"`
+	ExpiresOn  *time.Time         `json:"expires_on,omitempty"`
+	Policies   []APITokenPolicy   `json:"policies,omitempty"`
+	Condition  *APITokenCondition `json:"condition,omitempty"`
+	// Value is the secret token. It is only returned when a token is
+	// created.
+	Value string `json:"value,omitempty"`
+}
+
+// APITokenPolicy grants or denies the permissions of its permission groups
+// on its resources. Effect is "allow" or "deny".
+//
+// Resources maps resource names, such as those returned by
+// APITokenAccountResource and APITokenZoneResource, to "*".
+type APITokenPolicy struct {
+	ID               string                    `json:"id,omitempty"`
+	Effect           string                    `json:"effect"`
+	Resources        map[string]interface{}    `json:"resources"`
+	PermissionGroups []APITokenPermissionGroup `json:"permission_groups"`
+}
+
+// APITokenPermissionGroup is a named set of permissions that can be granted
+// by a policy. Only the ID is needed when creating a token.
+type APITokenPermissionGroup struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// APITokenCondition restricts where a token can be used from.
+type APITokenCondition struct {
+	RequestIP *APITokenRequestIPCondition `json:"request.ip,omitempty"`
+}
+
+// APITokenRequestIPCondition lists the IP ranges, in CIDR notation, a token
+// can and cannot be used from.
+type APITokenRequestIPCondition struct {
+	In    []string `json:"in,omitempty"`
+	NotIn []string `json:"not_in,omitempty"`
+}
+
+// APITokenVerification is the result of verifying a token.
+type APITokenVerification struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// APITokenResponse is the API response containing a single token.
+type APITokenResponse struct {
+	Response
+	Result APIToken `json:"result"`
+}
+
+// APITokenListResponse is the API response containing a list of tokens.
+type APITokenListResponse struct {
+	Response
+	Result     []APIToken `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// APITokenVerifyResponse is the API response from verifying a token.
+type APITokenVerifyResponse struct {
+	Response
+	Result APITokenVerification `json:"result"`
+}
+
+// APITokenRollResponse is the API response from rolling a token, containing
+// its new value.
+type APITokenRollResponse struct {
+	Response
+	Result string `json:"result"`
+}
+
+// APITokenPermissionGroupsResponse is the API response containing the
+// permission groups that can be granted to tokens.
+type APITokenPermissionGroupsResponse struct {
+	Response
+	Result []APITokenPermissionGroup `json:"result"`
+}
+
+// APITokenAccountResource returns the policy resource name for an account.
+func APITokenAccountResource(accountID string) string {
+	return "com.cloudflare.api.account." + accountID
+}
+
+// APITokenZoneResource returns the policy resource name for a zone. Pass "*"
+// for every zone the user can access.
+func APITokenZoneResource(zoneID string) string {
+	return "com.cloudflare.api.account.zone." + zoneID
+}
+
+// APITokenUserResource returns the policy resource name for a user.
+func APITokenUserResource(userID string) string {
+	return "com.cloudflare.api.user." + userID
+}
+
+// VerifyAPIToken checks the token the client authenticates with and
+// returns its status.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-verify-token
+func (api *API) VerifyAPIToken() (APITokenVerification, error) {
+	return api.VerifyAPITokenContext(context.TODO())
+}
+
+// VerifyAPITokenContext is like VerifyAPIToken but takes a context.
+func (api *API) VerifyAPITokenContext(ctx context.Context) (APITokenVerification, error) {
+	res, err := api.makeRequestContext(ctx, "GET", "/user/tokens/verify", nil)
+	if err != nil {
+		return APITokenVerification{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenVerifyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APITokenVerification{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
+
+// APITokens returns a page of the user's API tokens. Token values are not
+// included.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-list-tokens
+func (api *API) APITokens(pageOpts PaginationOptions) ([]APIToken, ResultInfo, error) {
+	return api.APITokensContext(context.TODO(), pageOpts)
+}
+
+// APITokensContext is like APITokens but takes a context.
+func (api *API) APITokensContext(ctx context.Context, pageOpts PaginationOptions) ([]APIToken, ResultInfo, error) {
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := "/user/tokens"
+	if len(v) > 0 {
+		uri = uri + "?" + v.Encode()
+	}
+
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return []APIToken{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []APIToken{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, r.ResultInfo, nil
+}
+
+// APITokenIterator iterates over API tokens. See Paginator.
+type APITokenIterator struct {
+	*Paginator
+}
+
+// Value returns the current API token.
+func (it *APITokenIterator) Value() APIToken {
+	v, _ := it.value().(APIToken)
+	return v
+}
+
+// All returns every remaining API token, fetching up to concurrency pages at
+// a time.
+func (it *APITokenIterator) All(concurrency int) ([]APIToken, error) {
+	var tokens []APIToken
+	if err := it.collect(concurrency, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// APITokensIter returns an iterator over the user's API tokens.
+func (api *API) APITokensIter(ctx context.Context, pageOpts PaginationOptions) *APITokenIterator {
+	return &APITokenIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.APITokensContext(ctx, pageOpts)
+	})}
+}
+
+// GetAPIToken returns a single API token. The token value is not included.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-token-details
+func (api *API) GetAPIToken(tokenID string) (APIToken, error) {
+	return api.GetAPITokenContext(context.TODO(), tokenID)
+}
+
+// GetAPITokenContext is like GetAPIToken but takes a context.
+func (api *API) GetAPITokenContext(ctx context.Context, tokenID string) (APIToken, error) {
+	res, err := api.makeRequestContext(ctx, "GET", "/user/tokens/"+tokenID, nil)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
+
+// CreateAPIToken creates a new API token. The returned token's Value is the
+// secret, which cannot be retrieved again.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-create-token
+func (api *API) CreateAPIToken(token APIToken) (APIToken, error) {
+	return api.CreateAPITokenContext(context.TODO(), token)
+}
+
+// CreateAPITokenContext is like CreateAPIToken but takes a context.
+func (api *API) CreateAPITokenContext(ctx context.Context, token APIToken) (APIToken, error) {
+	res, err := api.makeRequestContext(ctx, "POST", "/user/tokens", token)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateAPIToken replaces an existing API token's name, status, policies,
+// condition and validity period. Its value is unchanged.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-update-token
+func (api *API) UpdateAPIToken(tokenID string, token APIToken) (APIToken, error) {
+	return api.UpdateAPITokenContext(context.TODO(), tokenID, token)
+}
+
+// UpdateAPITokenContext is like UpdateAPIToken but takes a context.
+func (api *API) UpdateAPITokenContext(ctx context.Context, tokenID string, token APIToken) (APIToken, error) {
+	res, err := api.makeRequestContext(ctx, "PUT", "/user/tokens/"+tokenID, token)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
+
+// RollAPIToken replaces an API token's secret with a new one, which it
+// returns. The old value stops working immediately.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-roll-token
+func (api *API) RollAPIToken(tokenID string) (string, error) {
+	return api.RollAPITokenContext(context.TODO(), tokenID)
+}
+
+// RollAPITokenContext is like RollAPIToken but takes a context.
+func (api *API) RollAPITokenContext(ctx context.Context, tokenID string) (string, error) {
+	res, err := api.makeRequestContext(ctx, "PUT", "/user/tokens/"+tokenID+"/value", struct{}{})
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenRollResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteAPIToken deletes an API token.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-delete-token
+func (api *API) DeleteAPIToken(tokenID string) error {
+	return api.DeleteAPITokenContext(context.TODO(), tokenID)
+}
+
+// DeleteAPITokenContext is like DeleteAPIToken but takes a context.
+func (api *API) DeleteAPITokenContext(ctx context.Context, tokenID string) error {
+	_, err := api.makeRequestContext(ctx, "DELETE", "/user/tokens/"+tokenID, nil)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+
+	return nil
+}
+
+// APITokenPermissionGroups returns the permission groups that can be granted
+// to API tokens.
+//
+// API reference: https://api.cloudflare.com/#permission-groups-list-permission-groups
+func (api *API) APITokenPermissionGroups() ([]APITokenPermissionGroup, error) {
+	return api.APITokenPermissionGroupsContext(context.TODO())
+}
+
+// APITokenPermissionGroupsContext is like APITokenPermissionGroups but takes a
+// context.
+func (api *API) APITokenPermissionGroupsContext(ctx context.Context) ([]APITokenPermissionGroup, error) {
+	res, err := api.makeRequestContext(ctx, "GET", "/user/tokens/permission_groups", nil)
+	if err != nil {
+		return []APITokenPermissionGroup{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r APITokenPermissionGroupsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []APITokenPermissionGroup{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,277 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const apiTokenJSON = `{
+	"id": "ed17574386854bf78a67040be0a770b0",
+	"name": "readonly token",
+	"status": "active",
+	"issued_on": "2018-07-01T05:20:00Z",
+	"modified_on": "2018-07-02T05:20:00Z",
+	"not_before": "2018-07-01T05:20:00Z",
+	"expires_on": "2020-01-01T00:00:00Z",
+	"policies": [
+		{
+			"id": "f267e341f3dd4697bd3b9f71dd96247f",
+			"effect": "allow",
+			"resources": {
+				"com.cloudflare.api.account.zone.eb78d65290b24279ba6f44721b3ea3c4": "*"
+			},
+			"permission_groups": [
+				{
+					"id": "c8fed203ed3043cba015a93ad1616f1f",
+					"name": "Zone Read"
+				}
+			]
+		}
+	],
+	"condition": {
+		"request.ip": {
+			"in": ["199.27.128.0/21"],
+			"not_in": ["199.27.128.1/32"]
+		}
+	}
+}`
+
+var (
+	apiTokenIssuedOn, _   = time.Parse(time.RFC3339, "2018-07-01T05:20:00Z")
+	apiTokenModifiedOn, _ = time.Parse(time.RFC3339, "2018-07-02T05:20:00Z")
+	apiTokenExpiresOn, _  = time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+
+	expectedAPIToken = APIToken{
+		ID:         "ed17574386854bf78a67040be0a770b0",
+		Name:       "readonly token",
+		Status:     "active",
+		IssuedOn:   &apiTokenIssuedOn,
+		ModifiedOn: &apiTokenModifiedOn,
+		NotBefore:  &apiTokenIssuedOn,
+		ExpiresOn:  &apiTokenExpiresOn,
+		Policies: []APITokenPolicy{
+			{
+				ID:     "f267e341f3dd4697bd3b9f71dd96247f",
+				Effect: "allow",
+				Resources: map[string]interface{}{
+					APITokenZoneResource("eb78d65290b24279ba6f44721b3ea3c4"): "*",
+				},
+				PermissionGroups: []APITokenPermissionGroup{
+					{ID: "c8fed203ed3043cba015a93ad1616f1f", Name: "Zone Read"},
+				},
+			},
+		},
+		Condition: &APITokenCondition{
+			RequestIP: &APITokenRequestIPCondition{
+				In:    []string{"199.27.128.0/21"},
+				NotIn: []string{"199.27.128.1/32"},
+			},
+		},
+	}
+)
+
+func TestVerifyAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/verify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ed17574386854bf78a67040be0a770b0",
+				"status": "active",
+				"expires_on": "2020-01-01T00:00:00Z"
+			}
+		}`)
+	})
+
+	want := APITokenVerification{
+		ID:        "ed17574386854bf78a67040be0a770b0",
+		Status:    "active",
+		ExpiresOn: &apiTokenExpiresOn,
+	}
+
+	actual, err := client.VerifyAPIToken()
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestAPITokens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {
+				"page": 2,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 21
+			}
+		}`, apiTokenJSON)
+	})
+
+	actual, info, err := client.APITokens(PaginationOptions{Page: 2})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []APIToken{expectedAPIToken}, actual)
+		assert.Equal(t, 21, info.Total)
+	}
+}
+
+func TestGetAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, apiTokenJSON)
+	})
+
+	actual, err := client.GetAPIToken("ed17574386854bf78a67040be0a770b0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedAPIToken, actual)
+	}
+}
+
+func TestCreateAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+
+		b, _ := ioutil.ReadAll(r.Body)
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(b, &body))
+		assert.Equal(t, "readonly token", body["name"])
+		assert.Equal(t, "2020-01-01T00:00:00Z", body["expires_on"])
+		assert.NotContains(t, body, "id")
+		assert.NotContains(t, body, "issued_on")
+		condition, _ := body["condition"].(map[string]interface{})
+		assert.Contains(t, condition, "request.ip")
+
+		w.Header().Set("content-type", "application/json")
+		var token map[string]interface{}
+		json.Unmarshal([]byte(apiTokenJSON), &token)
+		token["value"] = "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"
+		result, _ := json.Marshal(token)
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, result)
+	})
+
+	token := APIToken{
+		Name:      expectedAPIToken.Name,
+		ExpiresOn: expectedAPIToken.ExpiresOn,
+		Policies: []APITokenPolicy{{
+			Effect:           "allow",
+			Resources:        map[string]interface{}{APITokenZoneResource("eb78d65290b24279ba6f44721b3ea3c4"): "*"},
+			PermissionGroups: []APITokenPermissionGroup{{ID: "c8fed203ed3043cba015a93ad1616f1f"}},
+		}},
+		Condition: expectedAPIToken.Condition,
+	}
+
+	want := expectedAPIToken
+	want.Value = "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"
+
+	actual, err := client.CreateAPIToken(token)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, apiTokenJSON)
+	})
+
+	actual, err := client.UpdateAPIToken("ed17574386854bf78a67040be0a770b0", expectedAPIToken)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedAPIToken, actual)
+	}
+}
+
+func TestRollAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0/value", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}`)
+	})
+
+	actual, err := client.RollAPIToken("ed17574386854bf78a67040be0a770b0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T", actual)
+	}
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ed17574386854bf78a67040be0a770b0"}}`)
+	})
+
+	err := client.DeleteAPIToken("ed17574386854bf78a67040be0a770b0")
+	assert.NoError(t, err)
+}
+
+func TestAPITokenPermissionGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/permission_groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "c8fed203ed3043cba015a93ad1616f1f",
+					"name": "Zone Read",
+					"scopes": ["com.cloudflare.api.account.zone"]
+				}
+			]
+		}`)
+	})
+
+	want := []APITokenPermissionGroup{{
+		ID:     "c8fed203ed3043cba015a93ad1616f1f",
+		Name:   "Zone Read",
+		Scopes: []string{"com.cloudflare.api.account.zone"},
+	}}
+
+	actual, err := client.APITokenPermissionGroups()
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -30,9 +31,14 @@ type ArgoDetailsResponse struct {
 //
 // API reference: https://api.cloudflare.com/#argo-smart-routing-get-argo-smart-routing-setting
 func (api *API) ArgoSmartRouting(zoneID string) (ArgoFeatureSetting, error) {
+	return api.ArgoSmartRoutingContext(context.TODO(), zoneID)
+}
+
+// ArgoSmartRoutingContext is like ArgoSmartRouting but takes a context.
+func (api *API) ArgoSmartRoutingContext(ctx context.Context, zoneID string) (ArgoFeatureSetting, error) {
 	uri := "/zones/" + zoneID + "/argo/smart_routing"
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ArgoFeatureSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -49,13 +55,18 @@ func (api *API) ArgoSmartRouting(zoneID string) (ArgoFeatureSetting, error) {
 //
 // API reference: https://api.cloudflare.com/#argo-smart-routing-patch-argo-smart-routing-setting
 func (api *API) UpdateArgoSmartRouting(zoneID, settingValue string) (ArgoFeatureSetting, error) {
+	return api.UpdateArgoSmartRoutingContext(context.TODO(), zoneID, settingValue)
+}
+
+// UpdateArgoSmartRoutingContext is like UpdateArgoSmartRouting but takes a context.
+func (api *API) UpdateArgoSmartRoutingContext(ctx context.Context, zoneID, settingValue string) (ArgoFeatureSetting, error) {
 	if !contains(validSettingValues, settingValue) {
 		return ArgoFeatureSetting{}, errors.New(fmt.Sprintf("invalid setting value '%s'. must be 'on' or 'off'", settingValue))
 	}
 
 	uri := "/zones/" + zoneID + "/argo/smart_routing"
 
-	res, err := api.makeRequest("PATCH", uri, ArgoFeatureSetting{Value: settingValue})
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, ArgoFeatureSetting{Value: settingValue})
 	if err != nil {
 		return ArgoFeatureSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -72,9 +83,14 @@ func (api *API) UpdateArgoSmartRouting(zoneID, settingValue string) (ArgoFeature
 //
 // API reference: TBA
 func (api *API) ArgoTieredCaching(zoneID string) (ArgoFeatureSetting, error) {
+	return api.ArgoTieredCachingContext(context.TODO(), zoneID)
+}
+
+// ArgoTieredCachingContext is like ArgoTieredCaching but takes a context.
+func (api *API) ArgoTieredCachingContext(ctx context.Context, zoneID string) (ArgoFeatureSetting, error) {
 	uri := "/zones/" + zoneID + "/argo/tiered_caching"
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ArgoFeatureSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -91,13 +107,18 @@ func (api *API) ArgoTieredCaching(zoneID string) (ArgoFeatureSetting, error) {
 //
 // API reference: TBA
 func (api *API) UpdateArgoTieredCaching(zoneID, settingValue string) (ArgoFeatureSetting, error) {
+	return api.UpdateArgoTieredCachingContext(context.TODO(), zoneID, settingValue)
+}
+
+// UpdateArgoTieredCachingContext is like UpdateArgoTieredCaching but takes a context.
+func (api *API) UpdateArgoTieredCachingContext(ctx context.Context, zoneID, settingValue string) (ArgoFeatureSetting, error) {
 	if !contains(validSettingValues, settingValue) {
 		return ArgoFeatureSetting{}, errors.New(fmt.Sprintf("invalid setting value '%s'. must be 'on' or 'off'", settingValue))
 	}
 
 	uri := "/zones/" + zoneID + "/argo/tiered_caching"
 
-	res, err := api.makeRequest("PATCH", uri, ArgoFeatureSetting{Value: settingValue})
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, ArgoFeatureSetting{Value: settingValue})
 	if err != nil {
 		return ArgoFeatureSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -106,9 +107,14 @@ func (a AuditLogFilter) String() string {
 //
 // API Reference: https://api.cloudflare.com/#audit-logs-list-organization-audit-logs
 func (api *API) GetOrganizationAuditLogs(organizationID string, a AuditLogFilter) (AuditLogResponse, error) {
+	return api.GetOrganizationAuditLogsContext(context.TODO(), organizationID, a)
+}
+
+// GetOrganizationAuditLogsContext is like GetOrganizationAuditLogs but takes a context.
+func (api *API) GetOrganizationAuditLogsContext(ctx context.Context, organizationID string, a AuditLogFilter) (AuditLogResponse, error) {
 	uri := "/organizations/" + organizationID + "/audit_logs" + fmt.Sprintf("%s", a)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AuditLogResponse{}, err
 	}
@@ -134,10 +140,63 @@ func unmarshalReturn(res []byte) (AuditLogResponse, error) {
 //
 // API Reference: https://api.cloudflare.com/#audit-logs-list-user-audit-logs
 func (api *API) GetUserAuditLogs(a AuditLogFilter) (AuditLogResponse, error) {
+	return api.GetUserAuditLogsContext(context.TODO(), a)
+}
+
+// GetUserAuditLogsContext is like GetUserAuditLogs but takes a context.
+func (api *API) GetUserAuditLogsContext(ctx context.Context, a AuditLogFilter) (AuditLogResponse, error) {
 	uri := "/user/audit_logs" + fmt.Sprintf("%s", a)
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return AuditLogResponse{}, err
 	}
 	return unmarshalReturn(res)
 }
+
+// AuditLogIterator iterates over audit logs. See Paginator.
+type AuditLogIterator struct {
+	*Paginator
+}
+
+// Value returns the current audit log.
+func (it *AuditLogIterator) Value() AuditLog {
+	v, _ := it.value().(AuditLog)
+	return v
+}
+
+// All returns every remaining audit log, fetching up to concurrency pages at
+// a time.
+func (it *AuditLogIterator) All(concurrency int) ([]AuditLog, error) {
+	var logs []AuditLog
+	if err := it.collect(concurrency, &logs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// GetOrganizationAuditLogsIter returns an iterator over the audit logs of an
+// organization, filtered by a. It starts at a.Page, if set.
+func (api *API) GetOrganizationAuditLogsIter(ctx context.Context, organizationID string, a AuditLogFilter) *AuditLogIterator {
+	return auditLogsIter(ctx, a, func(ctx context.Context, a AuditLogFilter) (AuditLogResponse, error) {
+		return api.GetOrganizationAuditLogsContext(ctx, organizationID, a)
+	})
+}
+
+// GetUserAuditLogsIter returns an iterator over your user's audit logs,
+// filtered by a. It starts at a.Page, if set.
+func (api *API) GetUserAuditLogsIter(ctx context.Context, a AuditLogFilter) *AuditLogIterator {
+	return auditLogsIter(ctx, a, api.GetUserAuditLogsContext)
+}
+
+func auditLogsIter(ctx context.Context, a AuditLogFilter, list func(context.Context, AuditLogFilter) (AuditLogResponse, error)) *AuditLogIterator {
+	pageOpts := PaginationOptions{Page: a.Page, PerPage: a.PerPage}
+	return &AuditLogIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		filter := a
+		filter.Page, filter.PerPage = pageOpts.Page, pageOpts.PerPage
+		r, err := list(ctx, filter)
+		if err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return r.Result, r.ResultInfo, nil
+	})}
+}
@@ -1,8 +1,13 @@
 package cloudflare
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAuditLogFilterStringify(t *testing.T) {
@@ -53,3 +58,28 @@ func TestAuditLogFilterStringify(t *testing.T) {
 		t.Fatalf("Did not properly stringify the page field: %s", filter.String())
 	}
 }
+
+func TestGetUserAuditLogsIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/audit_logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "example.com", r.URL.Query().Get("zone.name"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "log-%s"}],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 3}
+		}`, page, page)
+	})
+
+	logs, err := client.GetUserAuditLogsIter(context.Background(), AuditLogFilter{ZoneName: "example.com", Page: 2}).All(2)
+	if assert.NoError(t, err) && assert.Len(t, logs, 2) {
+		assert.Equal(t, "log-2", logs[0].ID)
+		assert.Equal(t, "log-3", logs[1].ID)
+	}
+}
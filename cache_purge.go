@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// PurgeCacheOptions configures BatchPurgeCache.
+type PurgeCacheOptions struct {
+	// ChunkSize is the most files, tags, hosts or prefixes to send in one
+	// request; the default is 30, the API's limit for most plans.
+	ChunkSize int
+	// Concurrency is the most requests to have in flight at once, or
+	// DefaultBatchConcurrency if zero.
+	Concurrency int
+}
+
+// PurgeCacheChunk is one of the requests made by BatchPurgeCache, with its
+// outcome.
+type PurgeCacheChunk struct {
+	Request PurgeCacheRequest
+	// ID is the ID the API gave the purge, if it succeeded.
+	ID string
+	// Err is why the request failed. Errors holds the codes and messages
+	// the API gave for a failure, if it responded.
+	Err    error
+	Errors []ResponseInfo
+}
+
+// PurgeCacheReport holds the outcome of every request made by
+// BatchPurgeCache.
+type PurgeCacheReport struct {
+	Chunks []PurgeCacheChunk
+}
+
+// Failed returns the requests that failed.
+func (r PurgeCacheReport) Failed() []PurgeCacheChunk {
+	var failed []PurgeCacheChunk
+	for _, chunk := range r.Chunks {
+		if chunk.Err != nil {
+			failed = append(failed, chunk)
+		}
+	}
+	return failed
+}
+
+// BatchPurgeCache purges the files, tags, hosts and prefixes in pcr from a
+// zone's cache, however many there are, by splitting them into requests
+// within the API's limits. Each request purges one kind of thing. A failed
+// request does not stop the others.
+//
+// The report always holds the outcome of every request. The error is
+// non-nil if any request failed.
+//
+// API reference: https://api.cloudflare.com/#zone-purge-files-by-url
+func (api *API) BatchPurgeCache(zoneID string, pcr PurgeCacheRequest, opts PurgeCacheOptions) (PurgeCacheReport, error) {
+	return api.BatchPurgeCacheContext(context.TODO(), zoneID, pcr, opts)
+}
+
+// BatchPurgeCacheContext is like BatchPurgeCache but takes a context.
+// Requests that have not been started when ctx is done fail with its error.
+func (api *API) BatchPurgeCacheContext(ctx context.Context, zoneID string, pcr PurgeCacheRequest, opts PurgeCacheOptions) (PurgeCacheReport, error) {
+	var report PurgeCacheReport
+	if pcr.Everything {
+		return report, errors.New("use PurgeEverything to purge everything")
+	}
+	size := opts.ChunkSize
+	if size < 1 {
+		size = 30
+	}
+
+	report.Chunks = chunkPurgeCacheRequest(pcr, size)
+	if len(report.Chunks) == 0 {
+		return report, errors.New("nothing to purge")
+	}
+
+	forEachConcurrently(opts.Concurrency, len(report.Chunks), func(i int) {
+		chunk := &report.Chunks[i]
+		if chunk.Err = ctx.Err(); chunk.Err != nil {
+			return
+		}
+		resp, err := api.PurgeCacheContext(ctx, zoneID, chunk.Request)
+		if err != nil {
+			chunk.Err = err
+			if apiErr, ok := apiErrorFrom(err); ok {
+				chunk.Errors = apiErr.Errors
+			}
+			return
+		}
+		chunk.ID = resp.Result.ID
+	})
+
+	if failed := len(report.Failed()); failed > 0 {
+		return report, errors.Errorf("%d of %d cache purge requests failed", failed, len(report.Chunks))
+	}
+	return report, nil
+}
+
+// chunkPurgeCacheRequest splits pcr into requests of at most size entries,
+// each purging one kind of thing.
+func chunkPurgeCacheRequest(pcr PurgeCacheRequest, size int) []PurgeCacheChunk {
+	var chunks []PurgeCacheChunk
+	split := func(list []string, set func(*PurgeCacheRequest, []string)) {
+		for len(list) > 0 {
+			n := size
+			if n > len(list) {
+				n = len(list)
+			}
+			var req PurgeCacheRequest
+			set(&req, list[:n:n])
+			chunks = append(chunks, PurgeCacheChunk{Request: req})
+			list = list[n:]
+		}
+	}
+
+	split(pcr.Files, func(req *PurgeCacheRequest, files []string) { req.Files = files })
+	for files := pcr.FilesWithHeaders; len(files) > 0; {
+		n := size
+		if n > len(files) {
+			n = len(files)
+		}
+		chunks = append(chunks, PurgeCacheChunk{Request: PurgeCacheRequest{FilesWithHeaders: files[:n:n]}})
+		files = files[n:]
+	}
+	split(pcr.Tags, func(req *PurgeCacheRequest, tags []string) { req.Tags = tags })
+	split(pcr.Hosts, func(req *PurgeCacheRequest, hosts []string) { req.Hosts = hosts })
+	split(pcr.Prefixes, func(req *PurgeCacheRequest, prefixes []string) { req.Prefixes = prefixes })
+	return chunks
+}
@@ -0,0 +1,104 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgeCacheRequest_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(PurgeCacheRequest{
+		Files: []string{"https://example.com/a.css"},
+		FilesWithHeaders: []PurgeCacheFile{
+			{URL: "https://example.com/b.css", Headers: map[string]string{"CF-Device-Type": "mobile"}},
+			{URL: "https://example.com/c.css"},
+		},
+		Prefixes: []string{"example.com/images"},
+	})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"files": [
+				"https://example.com/a.css",
+				{"url": "https://example.com/b.css", "headers": {"CF-Device-Type": "mobile"}},
+				"https://example.com/c.css"
+			],
+			"prefixes": ["example.com/images"]
+		}`, string(b))
+	}
+
+	b, err = json.Marshal(PurgeCacheRequest{Everything: true})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"purge_everything": true}`, string(b))
+	}
+}
+
+func TestBatchPurgeCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var (
+		mu       sync.Mutex
+		requests []map[string][]interface{}
+	)
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string][]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		requests = append(requests, body)
+		mu.Unlock()
+
+		w.Header().Set("content-type", "application/json")
+		if _, ok := body["tags"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1134, "message": "Purge by tag is an Enterprise feature"}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "9a7806061c88ada191ed06f989cc3dac"}}`)
+	})
+
+	var files []string
+	for i := 0; i < 65; i++ {
+		files = append(files, fmt.Sprintf("https://example.com/%d.css", i))
+	}
+	report, err := client.BatchPurgeCache("023e105f4ecef8ad9ca31a8372d0c353", PurgeCacheRequest{
+		Files:            files,
+		FilesWithHeaders: []PurgeCacheFile{{URL: "https://example.com/app.js", Headers: map[string]string{"Origin": "https://www.example.com"}}},
+		Tags:             []string{"css"},
+		Hosts:            []string{"static.example.com"},
+	}, PurgeCacheOptions{})
+	assert.EqualError(t, err, "1 of 6 cache purge requests failed")
+	if !assert.Len(t, report.Chunks, 6) {
+		return
+	}
+	assert.Len(t, report.Chunks[0].Request.Files, 30)
+	assert.Len(t, report.Chunks[1].Request.Files, 30)
+	assert.Equal(t, files[60:], report.Chunks[2].Request.Files)
+	assert.Len(t, report.Chunks[3].Request.FilesWithHeaders, 1)
+	assert.Equal(t, []string{"css"}, report.Chunks[4].Request.Tags)
+	assert.Equal(t, "9a7806061c88ada191ed06f989cc3dac", report.Chunks[5].ID)
+
+	failed := report.Failed()
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, []string{"css"}, failed[0].Request.Tags)
+		assert.Empty(t, failed[0].ID)
+		if assert.Len(t, failed[0].Errors, 1) {
+			assert.Equal(t, 1134, failed[0].Errors[0].Code)
+		}
+	}
+
+	assert.Len(t, requests, 6)
+	for _, body := range requests {
+		assert.Len(t, body, 1, "each request purges one kind of thing")
+		for _, list := range body {
+			assert.True(t, len(list) <= 30)
+		}
+	}
+
+	_, err = client.BatchPurgeCache("023e105f4ecef8ad9ca31a8372d0c353", PurgeCacheRequest{}, PurgeCacheOptions{})
+	assert.EqualError(t, err, "nothing to purge")
+}
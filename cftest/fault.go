@@ -0,0 +1,104 @@
+package cftest
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// Fault describes a misbehaviour of the server, injected with InjectFault.
+type Fault struct {
+	// Method and Path select the requests the fault applies to. An empty
+	// Method matches any method. Path is a path.Match pattern, such as
+	// "/zones/*/dns_records"; an empty Path matches any path.
+	Method string
+	Path   string
+
+	// Latency delays the response. If StatusCode is zero the request is then
+	// handled as normal.
+	Latency time.Duration
+	// StatusCode, if non-zero, is returned instead of handling the request.
+	// The response body is an API error; for 429 the error is the API's rate
+	// limiting error.
+	StatusCode int
+	// RetryAfter sets the Retry-After header of the response, rounded down to
+	// whole seconds.
+	RetryAfter time.Duration
+
+	// Times limits the fault to that many matching requests. Zero means every
+	// matching request.
+	Times int
+}
+
+// fault is an injected Fault and how many more requests it applies to.
+type fault struct {
+	Fault
+	remaining int
+}
+
+// InjectFault makes the server misbehave as described by f. Faults are
+// checked in the order they were injected and the first match applies.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{Fault: f, remaining: f.Times})
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// matchFault returns the fault that applies to r, if any, and counts r
+// against it. s.mu must be held.
+func (s *Server) matchFault(r *http.Request) *fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, r.URL.Path); !ok {
+				continue
+			}
+		}
+		if f.Times > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// apply delays the response and writes the fault's error, if it has one. It
+// returns true if the response has been written.
+func (f *fault) apply(w http.ResponseWriter, r *http.Request) bool {
+	if f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return true
+		}
+	}
+	if f.StatusCode == 0 {
+		return false
+	}
+
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter/time.Second)))
+	}
+	switch {
+	case f.StatusCode == http.StatusTooManyRequests:
+		writeError(w, f.StatusCode, 10000, "Rate limited. Please wait and consider throttling your request speed")
+	case f.StatusCode >= 500:
+		writeError(w, f.StatusCode, 10000, "Internal server error")
+	default:
+		writeError(w, f.StatusCode, 10000, http.StatusText(f.StatusCode))
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// lbResource serves the pool or monitor endpoints, which behave alike.
+type lbResource struct {
+	s        *Server
+	kind     string // "pools" or "monitors"
+	validate func(w http.ResponseWriter, scope string, o object) bool
+	defaults func(o object)
+}
+
+// lbScope returns the scope of the pools or monitors named by the route. They
+// belong to the user, or to an account when the client uses UsingAccount.
+func lbScope(p params, kind string) string {
+	if p["account"] != "" {
+		return "accounts/" + p["account"] + "/load_balancers/" + kind
+	}
+	return "user/load_balancers/" + kind
+}
+
+func (s *Server) registerLoadBalancingRoutes() {
+	pools := &lbResource{s: s, kind: "pools", validate: s.validatePool, defaults: poolDefaults}
+	monitors := &lbResource{s: s, kind: "monitors", validate: s.validateMonitor, defaults: monitorDefaults}
+
+	for _, prefix := range []string{"/user/load_balancers/", "/accounts/:account/load_balancers/"} {
+		for _, res := range []*lbResource{pools, monitors} {
+			s.handle("GET", prefix+res.kind, res.list)
+			s.handle("POST", prefix+res.kind, res.create)
+			s.handle("GET", prefix+res.kind+"/:id", res.get)
+			s.handle("PUT", prefix+res.kind+"/:id", res.update)
+			s.handle("PATCH", prefix+res.kind+"/:id", res.update)
+			s.handle("DELETE", prefix+res.kind+"/:id", res.delete)
+		}
+	}
+}
+
+func (res *lbResource) list(w http.ResponseWriter, r *http.Request, p params) {
+	writeResult(w, res.s.collection(lbScope(p, res.kind)).list())
+}
+
+func (res *lbResource) create(w http.ResponseWriter, r *http.Request, p params) {
+	var o object
+	if !decodeBody(w, r, &o) {
+		return
+	}
+	o["id"] = newID()
+	res.defaults(o)
+	scope := lbScope(p, res.kind)
+	if !res.validate(w, scope, o) {
+		return
+	}
+	now := timestamp()
+	o["created_on"] = now
+	o["modified_on"] = now
+	res.s.collection(scope).put(o)
+	writeResult(w, o.copy())
+}
+
+func (res *lbResource) get(w http.ResponseWriter, r *http.Request, p params) {
+	o, ok := res.s.collection(lbScope(p, res.kind)).get(p["id"])
+	if !ok {
+		res.writeNotFound(w)
+		return
+	}
+	writeResult(w, o.copy())
+}
+
+// update handles both PATCH, which changes only the fields given, and PUT,
+// which replaces the pool or monitor.
+func (res *lbResource) update(w http.ResponseWriter, r *http.Request, p params) {
+	scope := lbScope(p, res.kind)
+	c := res.s.collection(scope)
+	existing, ok := c.get(p["id"])
+	if !ok {
+		res.writeNotFound(w)
+		return
+	}
+	var body object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	o := existing.copy()
+	if r.Method == "PUT" {
+		o = object{}
+	}
+	o.merge(body)
+	o["id"] = existing.id()
+	res.defaults(o)
+	if !res.validate(w, scope, o) {
+		return
+	}
+	o["created_on"] = existing["created_on"]
+	o["modified_on"] = timestamp()
+	c.put(o)
+	writeResult(w, o.copy())
+}
+
+func (res *lbResource) delete(w http.ResponseWriter, r *http.Request, p params) {
+	scope := lbScope(p, res.kind)
+	if _, ok := res.s.collection(scope).get(p["id"]); !ok {
+		res.writeNotFound(w)
+		return
+	}
+	if res.kind == "monitors" {
+		for _, pool := range res.s.collection(lbScope(p, "pools")).list() {
+			if pool.str("monitor") == p["id"] {
+				writeError(w, http.StatusBadRequest, 1001, fmt.Sprintf("monitor is referenced by pool %s", pool.id()))
+				return
+			}
+		}
+	}
+	res.s.collection(scope).remove(p["id"])
+	writeResult(w, object{"id": p["id"]})
+}
+
+func (res *lbResource) writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, 1002, fmt.Sprintf("%s not found", res.kind[:len(res.kind)-1]))
+}
+
+// validatePool checks that pool has a name and origins and that its monitor,
+// if any, exists.
+func (s *Server) validatePool(w http.ResponseWriter, scope string, pool object) bool {
+	if pool.str("name") == "" {
+		writeError(w, http.StatusBadRequest, 1003, "pool name is required")
+		return false
+	}
+	if origins, _ := pool["origins"].([]interface{}); len(origins) == 0 {
+		writeError(w, http.StatusBadRequest, 1003, "pool must have at least one origin")
+		return false
+	}
+	if monitor := pool.str("monitor"); monitor != "" {
+		monitors := s.collection(strings.TrimSuffix(scope, "pools") + "monitors")
+		if _, ok := monitors.get(monitor); !ok {
+			writeError(w, http.StatusBadRequest, 1003, fmt.Sprintf("monitor %s does not exist", monitor))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) validateMonitor(w http.ResponseWriter, scope string, monitor object) bool {
+	switch monitor.str("type") {
+	case "http", "https", "tcp":
+		return true
+	}
+	writeError(w, http.StatusBadRequest, 1003, fmt.Sprintf("invalid monitor type %q", monitor.str("type")))
+	return false
+}
+
+func poolDefaults(pool object) {
+	if _, ok := pool["enabled"]; !ok {
+		pool["enabled"] = true
+	}
+	if pool.number("minimum_origins") == 0 {
+		pool["minimum_origins"] = 1
+	}
+}
+
+// monitorDefaults fills in the defaults the API applies to monitors.
+func monitorDefaults(monitor object) {
+	defaults := object{
+		"type":           "http",
+		"method":         "GET",
+		"path":           "/",
+		"timeout":        5,
+		"retries":        2,
+		"interval":       60,
+		"expected_codes": "200",
+	}
+	for k, v := range defaults {
+		if str, ok := monitor[k].(string); (ok && str != "") || monitor.number(k) != 0 {
+			continue
+		}
+		monitor[k] = v
+	}
+}
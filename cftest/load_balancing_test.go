@@ -0,0 +1,78 @@
+package cftest
+
+import (
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancing(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	monitor, err := api.CreateLoadBalancerMonitor(cloudflare.LoadBalancerMonitor{Path: "/health", ExpectedCodes: "2xx"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, monitor.ID, 32)
+	assert.Equal(t, "http", monitor.Type)
+	assert.Equal(t, "GET", monitor.Method)
+	assert.Equal(t, "/health", monitor.Path)
+	assert.Equal(t, 60, monitor.Interval)
+	assert.NotNil(t, monitor.CreatedOn)
+
+	_, err = api.CreateLoadBalancerPool(cloudflare.LoadBalancerPool{Name: "primary"})
+	assert.Error(t, err)
+	_, err = api.CreateLoadBalancerPool(cloudflare.LoadBalancerPool{
+		Name:    "primary",
+		Monitor: "0123456789abcdef0123456789abcdef",
+		Origins: []cloudflare.LoadBalancerOrigin{{Name: "app-1", Address: "192.0.2.1", Enabled: true, Weight: 1}},
+	})
+	assert.Error(t, err)
+
+	pool, err := api.CreateLoadBalancerPool(cloudflare.LoadBalancerPool{
+		Name:    "primary",
+		Enabled: true,
+		Monitor: monitor.ID,
+		Origins: []cloudflare.LoadBalancerOrigin{{Name: "app-1", Address: "192.0.2.1", Enabled: true, Weight: 1}},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, pool.MinimumOrigins)
+
+	pool.Description = "primary origins"
+	pool, err = api.ModifyLoadBalancerPool(pool)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary origins", pool.Description)
+
+	pools, err := api.ListLoadBalancerPools()
+	assert.NoError(t, err)
+	assert.Len(t, pools, 1)
+
+	assert.Error(t, api.DeleteLoadBalancerMonitor(monitor.ID))
+	assert.NoError(t, api.DeleteLoadBalancerPool(pool.ID))
+	assert.NoError(t, api.DeleteLoadBalancerMonitor(monitor.ID))
+
+	_, err = api.LoadBalancerMonitorDetails(monitor.ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+}
+
+func TestLoadBalancing_AccountScoped(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	userAPI, _ := srv.Client()
+	accountAPI, _ := srv.Client(cloudflare.UsingAccount("01a7362d577a6c3019a474fd6f485823"))
+
+	_, err := accountAPI.CreateLoadBalancerMonitor(cloudflare.LoadBalancerMonitor{Type: "https"})
+	assert.NoError(t, err)
+
+	monitors, err := accountAPI.ListLoadBalancerMonitors()
+	assert.NoError(t, err)
+	assert.Len(t, monitors, 1)
+
+	monitors, err = userAPI.ListLoadBalancerMonitors()
+	assert.NoError(t, err)
+	assert.Empty(t, monitors)
+}
@@ -0,0 +1,145 @@
+package cftest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cftest")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "dns.json")
+
+	// Record a session against a live server.
+	srv := NewServer()
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+
+	rec, err := NewRecorder(path, ModeAuto, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ModeRecord, rec.Mode())
+
+	api, err := cloudflare.New("secret-key", "user@example.com", cloudflare.HTTPClient(rec.Client()), cloudflare.UsingRateLimit(1000))
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = srv.URL
+
+	created, err := api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"})
+	assert.NoError(t, err)
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"})
+	assert.Error(t, err)
+	records, err := api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "A"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	assert.NoError(t, rec.Stop())
+	srv.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, string(b), "secret-key")
+	assert.NotContains(t, string(b), "user@example.com")
+	assert.Contains(t, string(b), redacted)
+
+	// Replay it with the server gone and different credentials.
+	rec, err = NewRecorder(path, ModeAuto, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ModeReplay, rec.Mode())
+
+	api, err = cloudflare.New("other-key", "other@example.com", cloudflare.HTTPClient(rec.Client()), cloudflare.UsingRetryPolicy(0, 0, 0))
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = srv.URL
+
+	replayed, err := api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Result.ID, replayed.Result.ID)
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"})
+	assert.True(t, cloudflare.IsConflict(err))
+	records, err = api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "A"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	// Every recorded interaction has been used.
+	_, err = api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "A"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "no recorded interaction")
+	}
+	assert.NoError(t, rec.Stop())
+}
+
+func TestRecorder_Matching(t *testing.T) {
+	rec := &Recorder{
+		mode: ModeReplay,
+		cassette: Cassette{Interactions: []Interaction{
+			{
+				Request:  RecordedRequest{Method: "GET", URL: "https://api.cloudflare.com/client/v4/zones?name=example.com&status=active"},
+				Response: RecordedResponse{StatusCode: 200, Body: "list"},
+			},
+			{
+				Request:  RecordedRequest{Method: "POST", URL: "https://api.cloudflare.com/client/v4/zones", Body: `{"name":"example.com","jump_start":false}`},
+				Response: RecordedResponse{StatusCode: 200, Body: "create"},
+			},
+		}},
+		used: make([]bool, 2),
+	}
+	client := rec.Client()
+
+	do := func(method, url, body string) string {
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		resp, err := client.Do(req)
+		if err != nil {
+			return err.Error()
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return string(b)
+	}
+
+	assert.Contains(t, do("GET", "https://api.cloudflare.com/client/v4/zones?name=example.com", ""), "no recorded interaction")
+	assert.Equal(t, "list", do("GET", "https://api.cloudflare.com/client/v4/zones?status=active&name=example.com", ""))
+	assert.Contains(t, do("POST", "https://api.cloudflare.com/client/v4/zones", `{"name":"example.org","jump_start":false}`), "no recorded interaction")
+	assert.Equal(t, "create", do("POST", "https://api.cloudflare.com/client/v4/zones", `{"jump_start": false, "name": "example.com"}`))
+}
+
+func TestRecorder_DoesNotModifyRequest(t *testing.T) {
+	rec := &Recorder{
+		mode: ModeReplay,
+		cassette: Cassette{Interactions: []Interaction{{
+			Request:  RecordedRequest{Method: "POST", URL: "https://api.cloudflare.com/client/v4/zones", Body: `{"name":"example.com"}`},
+			Response: RecordedResponse{StatusCode: 200, Body: "create"},
+		}}},
+		used: make([]bool, 1),
+	}
+
+	req, _ := http.NewRequest("POST", "https://api.cloudflare.com/client/v4/zones", strings.NewReader(`{"name":"example.com"}`))
+	body := req.Body
+	resp, err := rec.RoundTrip(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, 200, resp.StatusCode)
+	}
+	assert.True(t, req.Body == body, "the caller's request body is left in place")
+}
+
+func TestRecorder_ReplayMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join("testdata", "does-not-exist.json"), ModeReplay, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,324 @@
+package cftest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var firewallRulePageSize = pageSize{def: 25, max: 100}
+
+func firewallRuleScope(zoneID string) string {
+	return "zones/" + zoneID + "/firewall/rules"
+}
+
+func (s *Server) registerFirewallRuleRoutes() {
+	s.handle("GET", "/zones/:zone/firewall/rules", s.listFirewallRules)
+	s.handle("POST", "/zones/:zone/firewall/rules", s.createFirewallRules)
+	s.handle("PUT", "/zones/:zone/firewall/rules", s.updateFirewallRules)
+	s.handle("DELETE", "/zones/:zone/firewall/rules", s.deleteFirewallRules)
+	s.handle("GET", "/zones/:zone/firewall/rules/:id", s.getFirewallRule)
+	s.handle("PUT", "/zones/:zone/firewall/rules/:id", s.updateFirewallRule)
+	s.handle("DELETE", "/zones/:zone/firewall/rules/:id", s.deleteFirewallRule)
+}
+
+func (s *Server) listFirewallRules(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	query := r.URL.Query()
+	rules := filter(s.collection(firewallRuleScope(p["zone"])).list(), query, "id", "action", "paused")
+
+	page, info, ok := paginate(rules, query, firewallRulePageSize)
+	if !ok {
+		writeBadPagination(w)
+		return
+	}
+	writePage(w, page, info)
+}
+
+func (s *Server) createFirewallRules(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	var rules []object
+	if !decodeBody(w, r, &rules) {
+		return
+	}
+	for _, rule := range rules {
+		if !validateFirewallRule(w, rule) {
+			return
+		}
+	}
+
+	c := s.collection(firewallRuleScope(p["zone"]))
+	created := []object{}
+	for _, rule := range rules {
+		rule["id"] = newID()
+		now := timestamp()
+		rule["created_on"] = now
+		rule["modified_on"] = now
+		newFirewallRule(rule)
+		c.put(rule)
+		created = append(created, rule.copy())
+	}
+	writeResult(w, created)
+}
+
+func (s *Server) updateFirewallRules(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	var rules []object
+	if !decodeBody(w, r, &rules) {
+		return
+	}
+	c := s.collection(firewallRuleScope(p["zone"]))
+	for _, rule := range rules {
+		if _, ok := c.get(rule.id()); !ok {
+			writeFirewallRuleNotFound(w)
+			return
+		}
+		if !validateFirewallRule(w, rule) {
+			return
+		}
+	}
+
+	updated := []object{}
+	for _, rule := range rules {
+		updated = append(updated, replaceFirewallRule(c, rule))
+	}
+	writeResult(w, updated)
+}
+
+func (s *Server) deleteFirewallRules(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	var ids []string
+	for _, v := range r.URL.Query()["id"] {
+		ids = append(ids, strings.Split(v, ",")...)
+	}
+	c := s.collection(firewallRuleScope(p["zone"]))
+	for _, id := range ids {
+		if _, ok := c.get(id); !ok {
+			writeFirewallRuleNotFound(w)
+			return
+		}
+	}
+
+	deleted := []object{}
+	for _, id := range ids {
+		c.remove(id)
+		deleted = append(deleted, object{"id": id})
+	}
+	writeResult(w, deleted)
+}
+
+func (s *Server) getFirewallRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	rule, ok := s.collection(firewallRuleScope(p["zone"])).get(p["id"])
+	if !ok {
+		writeFirewallRuleNotFound(w)
+		return
+	}
+	writeResult(w, rule.copy())
+}
+
+func (s *Server) updateFirewallRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	c := s.collection(firewallRuleScope(p["zone"]))
+	if _, ok := c.get(p["id"]); !ok {
+		writeFirewallRuleNotFound(w)
+		return
+	}
+	var rule object
+	if !decodeBody(w, r, &rule) || !validateFirewallRule(w, rule) {
+		return
+	}
+	rule["id"] = p["id"]
+	writeResult(w, replaceFirewallRule(c, rule))
+}
+
+func (s *Server) deleteFirewallRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	if !s.collection(firewallRuleScope(p["zone"])).remove(p["id"]) {
+		writeFirewallRuleNotFound(w)
+		return
+	}
+	writeResult(w, object{"id": p["id"]})
+}
+
+func writeFirewallRuleNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, 10001, "firewallrules.api.not_found")
+}
+
+func validateFirewallRule(w http.ResponseWriter, rule object) bool {
+	if rule.str("action") == "" {
+		writeError(w, http.StatusBadRequest, 10014, "firewallrules.api.validation_error:action is required")
+		return false
+	}
+	if _, ok := rule["filter"].(map[string]interface{}); !ok {
+		writeError(w, http.StatusBadRequest, 10014, "firewallrules.api.validation_error:filter is required")
+		return false
+	}
+	return true
+}
+
+// replaceFirewallRule stores rule in place of the existing rule with the same
+// ID and returns a copy of it.
+func replaceFirewallRule(c *collection, rule object) object {
+	existing, _ := c.get(rule.id())
+	rule["created_on"] = existing["created_on"]
+	rule["modified_on"] = timestamp()
+	newFirewallRule(rule)
+	c.put(rule)
+	return rule.copy()
+}
+
+// newFirewallRule fills in the fields of rule the API sets itself. Filters
+// given inline are assigned an ID, as the API creates them alongside the
+// rule.
+func newFirewallRule(rule object) {
+	if _, ok := rule["paused"].(bool); !ok {
+		rule["paused"] = false
+	}
+	filter, _ := rule["filter"].(map[string]interface{})
+	if id, _ := filter["id"].(string); id == "" {
+		filter["id"] = newID()
+	}
+	if _, ok := filter["paused"].(bool); !ok {
+		filter["paused"] = false
+	}
+}
+
+func pageRuleScope(zoneID string) string {
+	return "zones/" + zoneID + "/pagerules"
+}
+
+func (s *Server) registerPageRuleRoutes() {
+	s.handle("GET", "/zones/:zone/pagerules", s.listPageRules)
+	s.handle("POST", "/zones/:zone/pagerules", s.createPageRule)
+	s.handle("GET", "/zones/:zone/pagerules/:id", s.getPageRule)
+	s.handle("PATCH", "/zones/:zone/pagerules/:id", s.updatePageRule)
+	s.handle("PUT", "/zones/:zone/pagerules/:id", s.updatePageRule)
+	s.handle("DELETE", "/zones/:zone/pagerules/:id", s.deletePageRule)
+}
+
+// listPageRules lists page rules by descending priority, as the API does by
+// default. Page rules are not paginated.
+func (s *Server) listPageRules(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	rules := filter(s.collection(pageRuleScope(p["zone"])).list(), r.URL.Query(), "status")
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].number("priority") > rules[j].number("priority")
+	})
+	writeResult(w, rules)
+}
+
+func (s *Server) createPageRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	var rule object
+	if !decodeBody(w, r, &rule) || !validatePageRule(w, rule) {
+		return
+	}
+	rule["id"] = newID()
+	now := timestamp()
+	rule["created_on"] = now
+	rule["modified_on"] = now
+	newPageRule(rule)
+	s.collection(pageRuleScope(p["zone"])).put(rule)
+	writeResult(w, rule.copy())
+}
+
+func (s *Server) getPageRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	rule, ok := s.collection(pageRuleScope(p["zone"])).get(p["id"])
+	if !ok {
+		writePageRuleNotFound(w)
+		return
+	}
+	writeResult(w, rule.copy())
+}
+
+// updatePageRule handles both PATCH, which changes only the fields given, and
+// PUT, which replaces the rule.
+func (s *Server) updatePageRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	c := s.collection(pageRuleScope(p["zone"]))
+	existing, ok := c.get(p["id"])
+	if !ok {
+		writePageRuleNotFound(w)
+		return
+	}
+	var body object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	rule := existing.copy()
+	if r.Method == "PUT" {
+		rule = object{}
+	}
+	rule.merge(body)
+	if !validatePageRule(w, rule) {
+		return
+	}
+	rule["id"] = existing.id()
+	rule["created_on"] = existing["created_on"]
+	rule["modified_on"] = timestamp()
+	newPageRule(rule)
+	c.put(rule)
+	writeResult(w, rule.copy())
+}
+
+func (s *Server) deletePageRule(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	if !s.collection(pageRuleScope(p["zone"])).remove(p["id"]) {
+		writePageRuleNotFound(w)
+		return
+	}
+	writeResult(w, object{"id": p["id"]})
+}
+
+func writePageRuleNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, 1002, "Invalid Page Rule identifier")
+}
+
+func validatePageRule(w http.ResponseWriter, rule object) bool {
+	if targets, _ := rule["targets"].([]interface{}); len(targets) == 0 {
+		writeError(w, http.StatusBadRequest, 1004, "Page Rule validation failed: See messages for details.")
+		return false
+	}
+	if actions, _ := rule["actions"].([]interface{}); len(actions) == 0 {
+		writeError(w, http.StatusBadRequest, 1004, "Page Rule validation failed: See messages for details.")
+		return false
+	}
+	return true
+}
+
+// newPageRule fills in the fields of rule the API defaults.
+func newPageRule(rule object) {
+	if rule.str("status") == "" {
+		rule["status"] = "disabled"
+	}
+	if rule.number("priority") == 0 {
+		rule["priority"] = 1
+	}
+}
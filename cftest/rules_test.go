@@ -0,0 +1,123 @@
+package cftest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirewallRules(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+
+	var rules []cloudflare.FirewallRule
+	for i := 0; i < 30; i++ {
+		rules = append(rules, cloudflare.FirewallRule{
+			Action:      "block",
+			Description: fmt.Sprintf("rule %d", i),
+			Filter:      cloudflare.Filter{Expression: fmt.Sprintf("ip.src eq 192.0.2.%d", i)},
+		})
+	}
+	created, err := api.CreateFirewallRules(zone.ID, rules)
+	if !assert.NoError(t, err) || !assert.Len(t, created, 30) {
+		return
+	}
+	assert.Len(t, created[0].ID, 32)
+	assert.Len(t, created[0].Filter.ID, 32)
+	assert.Equal(t, "ip.src eq 192.0.2.0", created[0].Filter.Expression)
+
+	_, err = api.CreateFirewallRules(zone.ID, []cloudflare.FirewallRule{{Filter: cloudflare.Filter{Expression: "true"}}})
+	assert.Error(t, err)
+
+	page, err := api.FirewallRules(zone.ID, cloudflare.PaginationOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, page, 25)
+
+	all, err := api.FirewallRulesIter(context.Background(), zone.ID, cloudflare.PaginationOptions{PerPage: 10}).All(2)
+	assert.NoError(t, err)
+	assert.Len(t, all, 30)
+
+	rule := created[0]
+	rule.Action = "challenge"
+	rule.Paused = true
+	updated, err := api.UpdateFirewallRule(zone.ID, rule)
+	assert.NoError(t, err)
+	assert.Equal(t, "challenge", updated.Action)
+	assert.True(t, updated.Paused)
+	assert.Equal(t, created[0].CreatedOn, updated.CreatedOn)
+
+	updatedRules, err := api.UpdateFirewallRules(zone.ID, []cloudflare.FirewallRule{created[1], created[2]})
+	assert.NoError(t, err)
+	assert.Len(t, updatedRules, 2)
+
+	fetched, err := api.FirewallRule(zone.ID, rule.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "challenge", fetched.Action)
+
+	assert.NoError(t, api.DeleteFirewallRule(zone.ID, created[0].ID))
+	assert.NoError(t, api.DeleteFirewallRules(zone.ID, []string{created[1].ID, created[2].ID}))
+	_, err = api.FirewallRule(zone.ID, created[1].ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+
+	all, err = api.FirewallRulesIter(context.Background(), zone.ID, cloudflare.PaginationOptions{}).All(1)
+	assert.NoError(t, err)
+	assert.Len(t, all, 27)
+}
+
+func TestPageRules(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+
+	newRule := func(url string, priority int) cloudflare.PageRule {
+		target := cloudflare.PageRuleTarget{Target: "url"}
+		target.Constraint.Operator = "matches"
+		target.Constraint.Value = url
+		return cloudflare.PageRule{
+			Targets:  []cloudflare.PageRuleTarget{target},
+			Actions:  []cloudflare.PageRuleAction{{ID: "always_use_https"}},
+			Priority: priority,
+			Status:   "active",
+		}
+	}
+
+	low, err := api.CreatePageRule(zone.ID, newRule("*example.com/low*", 1))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, low.ID, 32)
+	high, err := api.CreatePageRule(zone.ID, newRule("*example.com/high*", 2))
+	assert.NoError(t, err)
+
+	_, err = api.CreatePageRule(zone.ID, cloudflare.PageRule{Status: "active"})
+	assert.Error(t, err)
+
+	rules, err := api.ListPageRules(zone.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, rules, 2) {
+		assert.Equal(t, high.ID, rules[0].ID)
+		assert.Equal(t, low.ID, rules[1].ID)
+	}
+
+	assert.NoError(t, api.ChangePageRule(zone.ID, low.ID, cloudflare.PageRule{Status: "disabled"}))
+	rule, err := api.PageRule(zone.ID, low.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "disabled", rule.Status)
+	assert.Len(t, rule.Targets, 1)
+
+	assert.NoError(t, api.UpdatePageRule(zone.ID, low.ID, newRule("*example.com/other*", 3)))
+	rule, err = api.PageRule(zone.ID, low.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "*example.com/other*", rule.Targets[0].Constraint.Value)
+	assert.Equal(t, 3, rule.Priority)
+
+	assert.NoError(t, api.DeletePageRule(zone.ID, high.ID))
+	_, err = api.PageRule(zone.ID, high.ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+}
@@ -0,0 +1,292 @@
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// secondaryDNSResource serves the account's zone transfer peer or TSIG key
+// endpoints, which behave alike.
+type secondaryDNSResource struct {
+	s        *Server
+	kind     string // "peers" or "tsigs"
+	what     string
+	validate func(w http.ResponseWriter, p params, o object) bool
+}
+
+func secondaryDNSScope(p params, kind string) string {
+	return "accounts/" + p["account"] + "/secondary_dns/" + kind
+}
+
+// Incoming and outgoing transfer configurations are stored one per zone,
+// keyed by zone ID.
+const (
+	incomingTransferScope = "secondary_dns/incoming"
+	outgoingTransferScope = "secondary_dns/outgoing"
+)
+
+func (s *Server) registerSecondaryDNSRoutes() {
+	peers := &secondaryDNSResource{s: s, kind: "peers", what: "Peer", validate: s.validatePeer}
+	tsigs := &secondaryDNSResource{s: s, kind: "tsigs", what: "TSIG", validate: validateTSIG}
+	for _, res := range []*secondaryDNSResource{peers, tsigs} {
+		prefix := "/accounts/:account/secondary_dns/" + res.kind
+		s.handle("GET", prefix, res.list)
+		s.handle("POST", prefix, res.create)
+		s.handle("GET", prefix+"/:id", res.get)
+		s.handle("PUT", prefix+"/:id", res.update)
+		s.handle("DELETE", prefix+"/:id", res.delete)
+	}
+
+	for _, dir := range []string{"incoming", "outgoing"} {
+		t := &transferConfig{s: s, dir: dir}
+		s.handle("GET", "/zones/:zone/secondary_dns/"+dir, t.get)
+		s.handle("POST", "/zones/:zone/secondary_dns/"+dir, t.create)
+		s.handle("PUT", "/zones/:zone/secondary_dns/"+dir, t.update)
+		s.handle("DELETE", "/zones/:zone/secondary_dns/"+dir, t.delete)
+	}
+	s.handle("POST", "/zones/:zone/secondary_dns/force_axfr", s.forceAXFR)
+	s.handle("POST", "/zones/:zone/secondary_dns/outgoing/enable", s.setOutgoingTransfer(true))
+	s.handle("POST", "/zones/:zone/secondary_dns/outgoing/disable", s.setOutgoingTransfer(false))
+	s.handle("POST", "/zones/:zone/secondary_dns/outgoing/force_notify", s.forceNotify)
+}
+
+func (res *secondaryDNSResource) list(w http.ResponseWriter, r *http.Request, p params) {
+	writeResult(w, res.s.collection(secondaryDNSScope(p, res.kind)).list())
+}
+
+func (res *secondaryDNSResource) create(w http.ResponseWriter, r *http.Request, p params) {
+	var o object
+	if !decodeBody(w, r, &o) {
+		return
+	}
+	o["id"] = newID()
+	if !res.validate(w, p, o) {
+		return
+	}
+	res.s.collection(secondaryDNSScope(p, res.kind)).put(o)
+	writeResult(w, o.copy())
+}
+
+func (res *secondaryDNSResource) get(w http.ResponseWriter, r *http.Request, p params) {
+	o, ok := res.s.collection(secondaryDNSScope(p, res.kind)).get(p["id"])
+	if !ok {
+		writeNotFound(w, res.what)
+		return
+	}
+	writeResult(w, o.copy())
+}
+
+func (res *secondaryDNSResource) update(w http.ResponseWriter, r *http.Request, p params) {
+	c := res.s.collection(secondaryDNSScope(p, res.kind))
+	if _, ok := c.get(p["id"]); !ok {
+		writeNotFound(w, res.what)
+		return
+	}
+	var o object
+	if !decodeBody(w, r, &o) {
+		return
+	}
+	o["id"] = p["id"]
+	if !res.validate(w, p, o) {
+		return
+	}
+	c.put(o)
+	writeResult(w, o.copy())
+}
+
+func (res *secondaryDNSResource) delete(w http.ResponseWriter, r *http.Request, p params) {
+	if !res.s.collection(secondaryDNSScope(p, res.kind)).remove(p["id"]) {
+		writeNotFound(w, res.what)
+		return
+	}
+	writeResult(w, object{"id": p["id"]})
+}
+
+func (s *Server) validatePeer(w http.ResponseWriter, p params, o object) bool {
+	if o.str("name") == "" {
+		writeError(w, http.StatusBadRequest, 1000, "Peer name is required")
+		return false
+	}
+	if tsigID := o.str("tsig_id"); tsigID != "" {
+		if _, ok := s.collection(secondaryDNSScope(p, "tsigs")).get(tsigID); !ok {
+			writeError(w, http.StatusBadRequest, 1000, fmt.Sprintf("TSIG %s does not exist", tsigID))
+			return false
+		}
+	}
+	return true
+}
+
+func validateTSIG(w http.ResponseWriter, p params, o object) bool {
+	if o.str("name") == "" || o.str("secret") == "" || o.str("algo") == "" {
+		writeError(w, http.StatusBadRequest, 1000, "TSIG name, secret and algo are required")
+		return false
+	}
+	return true
+}
+
+// transferConfig serves a zone's incoming or outgoing transfer
+// configuration. Incoming transfers may only be configured for secondary
+// zones, and outgoing transfers only for zones that are not.
+type transferConfig struct {
+	s   *Server
+	dir string // "incoming" or "outgoing"
+}
+
+func (t *transferConfig) scope() string {
+	if t.dir == "incoming" {
+		return incomingTransferScope
+	}
+	return outgoingTransferScope
+}
+
+func (t *transferConfig) get(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := t.s.zone(w, p); !ok {
+		return
+	}
+	o, ok := t.s.collection(t.scope()).get(p["zone"])
+	if !ok {
+		writeNotFound(w, "Zone transfer configuration")
+		return
+	}
+	writeResult(w, transferConfigResult(o))
+}
+
+func (t *transferConfig) create(w http.ResponseWriter, r *http.Request, p params) {
+	z, ok := t.s.zone(w, p)
+	if !ok {
+		return
+	}
+	if _, exists := t.s.collection(t.scope()).get(p["zone"]); exists {
+		writeError(w, http.StatusBadRequest, 1000, "Zone transfer configuration already exists")
+		return
+	}
+	t.put(w, r, p, z, timestamp())
+}
+
+func (t *transferConfig) update(w http.ResponseWriter, r *http.Request, p params) {
+	z, ok := t.s.zone(w, p)
+	if !ok {
+		return
+	}
+	existing, ok := t.s.collection(t.scope()).get(p["zone"])
+	if !ok {
+		writeNotFound(w, "Zone transfer configuration")
+		return
+	}
+	t.put(w, r, p, z, existing.str("created_time"))
+}
+
+// put stores the configuration in the request body, after checking that it
+// suits the zone and names existing peers.
+func (t *transferConfig) put(w http.ResponseWriter, r *http.Request, p params, z object, created string) {
+	if secondary := z.str("type") == "secondary"; secondary != (t.dir == "incoming") {
+		writeError(w, http.StatusBadRequest, 1000, fmt.Sprintf("%s transfers are not supported for %s zones", t.dir, z.str("type")))
+		return
+	}
+	var o object
+	if !decodeBody(w, r, &o) {
+		return
+	}
+	peers, _ := o["peers"].([]interface{})
+	if t.dir == "incoming" && len(peers) == 0 {
+		writeError(w, http.StatusBadRequest, 1000, "At least one peer is required")
+		return
+	}
+	account, _ := z["account"].(map[string]interface{})
+	accountID, _ := account["id"].(string)
+	for _, peer := range peers {
+		id, _ := peer.(string)
+		if _, ok := t.s.collection(secondaryDNSScope(params{"account": accountID}, "peers")).get(id); !ok {
+			writeError(w, http.StatusBadRequest, 1000, fmt.Sprintf("Peer %v does not exist", peer))
+			return
+		}
+	}
+
+	c := t.s.collection(t.scope())
+	if existing, ok := c.get(p["zone"]); ok {
+		for _, field := range []string{"soa_serial", "checked_time", "last_transferred_time", "enabled"} {
+			if v, ok := existing[field]; ok {
+				o[field] = v
+			}
+		}
+	}
+	o["id"] = p["zone"]
+	o["name"] = z.str("name")
+	o["created_time"] = created
+	if t.dir == "incoming" {
+		o["modified_time"] = timestamp()
+	}
+	c.put(o)
+	writeResult(w, transferConfigResult(o))
+}
+
+func (t *transferConfig) delete(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := t.s.zone(w, p); !ok {
+		return
+	}
+	if !t.s.collection(t.scope()).remove(p["zone"]) {
+		writeNotFound(w, "Zone transfer configuration")
+		return
+	}
+	writeResult(w, object{"id": p["zone"]})
+}
+
+// transferConfigResult returns a copy of the stored configuration without
+// the fields the server keeps for itself.
+func transferConfigResult(o object) object {
+	c := o.copy()
+	delete(c, "enabled")
+	return c
+}
+
+// forceAXFR simulates a successful transfer from the zone's primaries, which
+// bumps the SOA serial.
+func (s *Server) forceAXFR(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	o, ok := s.collection(incomingTransferScope).get(p["zone"])
+	if !ok {
+		writeNotFound(w, "Zone transfer configuration")
+		return
+	}
+	o["soa_serial"] = o.number("soa_serial") + 1
+	o["checked_time"] = timestamp()
+	writeResult(w, "OK")
+}
+
+func (s *Server) setOutgoingTransfer(enabled bool) func(w http.ResponseWriter, r *http.Request, p params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		if _, ok := s.zone(w, p); !ok {
+			return
+		}
+		o, ok := s.collection(outgoingTransferScope).get(p["zone"])
+		if !ok {
+			writeNotFound(w, "Zone transfer configuration")
+			return
+		}
+		o["enabled"] = enabled
+		if enabled {
+			writeResult(w, "Enabled")
+		} else {
+			writeResult(w, "Disabled")
+		}
+	}
+}
+
+func (s *Server) forceNotify(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	o, ok := s.collection(outgoingTransferScope).get(p["zone"])
+	if !ok {
+		writeNotFound(w, "Zone transfer configuration")
+		return
+	}
+	if enabled, _ := o["enabled"].(bool); !enabled {
+		writeError(w, http.StatusBadRequest, 1000, "Outgoing zone transfers are disabled")
+		return
+	}
+	o["last_transferred_time"] = timestamp()
+	writeResult(w, "OK")
+}
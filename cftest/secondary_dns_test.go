@@ -0,0 +1,102 @@
+package cftest
+
+import (
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecondaryDNS(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	account := cloudflare.Account{ID: "01a7362d577a6c3019a474fd6f485823"}
+	zone, err := api.CreateZone("example.com", false, account, "secondary")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "secondary", zone.Type)
+
+	tsig, err := api.CreateSecondaryDNSTSIG(account.ID, cloudflare.SecondaryDNSTSIG{
+		Name:   "tsig.example.com.",
+		Secret: "c2VjcmV0",
+		Algo:   cloudflare.TSIGAlgorithmHMACSHA256,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = api.CreateSecondaryDNSPeer(account.ID, cloudflare.SecondaryDNSPeer{Name: "primary", TSIGID: "0123456789abcdef0123456789abcdef"})
+	assert.Error(t, err)
+	peer, err := api.CreateSecondaryDNSPeer(account.ID, cloudflare.SecondaryDNSPeer{Name: "primary", IP: "192.0.2.53", Port: 53, TSIGID: tsig.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.CreateSecondaryDNSZoneConfig(zone.ID, cloudflare.SecondaryDNSZone{Peers: []string{"0123456789abcdef0123456789abcdef"}})
+	assert.Error(t, err)
+	config, err := api.CreateSecondaryDNSZoneConfig(zone.ID, cloudflare.SecondaryDNSZone{Peers: []string{peer.ID}, AutoRefreshSeconds: 3600})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "example.com", config.Name)
+	assert.Equal(t, 3600, config.AutoRefreshSeconds)
+	assert.NotNil(t, config.CreatedTime)
+	assert.Nil(t, config.CheckedTime)
+
+	assert.NoError(t, api.ForceSecondaryDNSZoneAXFR(zone.ID))
+	config, err = api.SecondaryDNSZoneConfig(zone.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, config.SOASerial)
+	assert.NotNil(t, config.CheckedTime)
+
+	config.AutoRefreshSeconds = 600
+	config, err = api.UpdateSecondaryDNSZoneConfig(zone.ID, config)
+	assert.NoError(t, err)
+	assert.Equal(t, 600, config.AutoRefreshSeconds)
+	assert.Equal(t, 1, config.SOASerial)
+
+	_, err = api.CreateOutgoingZoneTransferConfig(zone.ID, cloudflare.OutgoingZoneTransfer{Peers: []string{peer.ID}})
+	assert.Error(t, err, "secondary zones cannot be transferred out")
+
+	assert.Error(t, api.DeleteSecondaryDNSPeer(account.ID, "0123456789abcdef0123456789abcdef"))
+	assert.NoError(t, api.DeleteSecondaryDNSZoneConfig(zone.ID))
+	_, err = api.SecondaryDNSZoneConfig(zone.ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+}
+
+func TestOutgoingZoneTransfer(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	account := cloudflare.Account{ID: "01a7362d577a6c3019a474fd6f485823"}
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com", Account: account})
+	peer, err := api.CreateSecondaryDNSPeer(account.ID, cloudflare.SecondaryDNSPeer{Name: "secondary", IP: "192.0.2.54"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.CreateSecondaryDNSZoneConfig(zone.ID, cloudflare.SecondaryDNSZone{Peers: []string{peer.ID}})
+	assert.Error(t, err, "full zones cannot be secondaries")
+
+	config, err := api.CreateOutgoingZoneTransferConfig(zone.ID, cloudflare.OutgoingZoneTransfer{Peers: []string{peer.ID}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{peer.ID}, config.Peers)
+
+	assert.Error(t, api.ForceOutgoingZoneTransferNotify(zone.ID), "transfers are disabled")
+	status, err := api.EnableOutgoingZoneTransfer(zone.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Enabled", status)
+	assert.NoError(t, api.ForceOutgoingZoneTransferNotify(zone.ID))
+
+	config, err = api.OutgoingZoneTransferConfig(zone.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, config.LastTransferredTime)
+
+	status, err = api.DisableOutgoingZoneTransfer(zone.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Disabled", status)
+	assert.NoError(t, api.DeleteOutgoingZoneTransferConfig(zone.ID))
+}
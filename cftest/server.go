@@ -0,0 +1,296 @@
+// Package cftest provides an in-memory fake of the Cloudflare v4 API for
+// testing code that uses the cloudflare package.
+//
+// The fake is stateful: resources created through it are assigned IDs and
+// timestamps, can be listed (with pagination, where the real API paginates),
+// updated and deleted, and are seen by later requests. It covers zones, DNS
+// records, firewall rules, page rules, Workers routes, Workers KV namespaces
+// and values, load balancer pools and monitors, and secondary DNS zone
+// transfer configuration.
+//
+//	srv := cftest.NewServer()
+//	defer srv.Close()
+//
+//	api, err := srv.Client()
+//	...
+//	zone, err := api.CreateZone("example.com", false, cloudflare.Account{}, "full")
+//
+// Faults such as rate limiting, server errors and latency can be injected with
+// InjectFault to exercise retry and error handling.
+//
+// For tests that need real API responses, Recorder records a session with the
+// API to a file and replays it later without credentials or network access.
+package cftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// Server is an in-memory fake of the Cloudflare v4 API. Point a client at it by
+// setting API.BaseURL to URL, or use Client. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the fake API.
+	URL string
+
+	srv    *httptest.Server
+	routes []route
+
+	mu          sync.Mutex
+	zones       *collection
+	collections map[string]*collection
+	kv          map[string]map[string][]byte
+	faults      []*fault
+	requests    []Request
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method   string
+	Path     string
+	RawQuery string
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		zones:       newCollection(),
+		collections: make(map[string]*collection),
+		kv:          make(map[string]map[string][]byte),
+	}
+	s.registerZoneRoutes()
+	s.registerDNSRoutes()
+	s.registerFirewallRuleRoutes()
+	s.registerPageRuleRoutes()
+	s.registerWorkerRoutes()
+	s.registerWorkersKVRoutes()
+	s.registerLoadBalancingRoutes()
+	s.registerSecondaryDNSRoutes()
+
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests on
+// it have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns an API client that talks to the server. The client
+// authenticates with a dummy API key and is not rate limited; opts are
+// applied after those defaults.
+func (s *Server) Client(opts ...cloudflare.Option) (*cloudflare.API, error) {
+	opts = append([]cloudflare.Option{cloudflare.UsingRateLimit(1000)}, opts...)
+	api, err := cloudflare.New("deadbeef", "cftest@example.com", opts...)
+	if err != nil {
+		return nil, err
+	}
+	api.BaseURL = s.URL
+	return api, nil
+}
+
+// Requests returns the requests the server has received, in order. Requests
+// answered by an injected fault are included.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// collection returns the collection of objects stored under scope, such as
+// "zones/<id>/dns_records", creating it if needed. s.mu must be held.
+func (s *Server) collection(scope string) *collection {
+	c, ok := s.collections[scope]
+	if !ok {
+		c = newCollection()
+		s.collections[scope] = c
+	}
+	return c
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, RawQuery: r.URL.RawQuery})
+	f := s.matchFault(r)
+	s.mu.Unlock()
+
+	if f != nil && f.apply(w, r) {
+		return
+	}
+
+	if !authenticated(r) {
+		writeError(w, http.StatusBadRequest, 6003, "Invalid request headers")
+		return
+	}
+
+	segments := splitPath(r.URL.EscapedPath())
+	pathMatched := false
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		rt.handler(w, r, params)
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, 10000, "Method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, 7000, "No route for that URI")
+}
+
+// authenticated reports whether r carries one of the credentials the API
+// accepts. The credentials themselves are not checked.
+func authenticated(r *http.Request) bool {
+	if r.Header.Get("X-Auth-Key") != "" && r.Header.Get("X-Auth-Email") != "" {
+		return true
+	}
+	if strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		return true
+	}
+	return r.Header.Get("X-Auth-User-Service-Key") != ""
+}
+
+// params holds the values of the variable segments of a route.
+type params map[string]string
+
+// route maps a method and path pattern to a handler. Pattern segments
+// starting with ":" match any single path segment. Handlers are called with
+// the server lock held.
+type route struct {
+	method  string
+	pattern []string
+	handler func(w http.ResponseWriter, r *http.Request, p params)
+}
+
+func (s *Server) handle(method, pattern string, handler func(w http.ResponseWriter, r *http.Request, p params)) {
+	s.routes = append(s.routes, route{method: method, pattern: splitPath(pattern), handler: handler})
+}
+
+func (rt route) match(segments []string) (params, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+	p := params{}
+	for i, seg := range rt.pattern {
+		if strings.HasPrefix(seg, ":") {
+			v, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			p[seg[1:]] = v
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// envelope is the body of every JSON response from the API.
+type envelope struct {
+	Success    bool                      `json:"success"`
+	Errors     []cloudflare.ResponseInfo `json:"errors"`
+	Messages   []cloudflare.ResponseInfo `json:"messages"`
+	Result     interface{}               `json:"result"`
+	ResultInfo interface{}               `json:"result_info,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body envelope) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeResult writes a successful response holding result.
+func writeResult(w http.ResponseWriter, result interface{}) {
+	writeJSON(w, http.StatusOK, envelope{
+		Success:  true,
+		Errors:   []cloudflare.ResponseInfo{},
+		Messages: []cloudflare.ResponseInfo{},
+		Result:   result,
+	})
+}
+
+// writePage writes a successful response holding one page of a list.
+func writePage(w http.ResponseWriter, result []object, info cloudflare.ResultInfo) {
+	writeResultWithInfo(w, result, info)
+}
+
+// writeResultWithInfo writes a successful response holding result, with info
+// as its result_info.
+func writeResultWithInfo(w http.ResponseWriter, result, info interface{}) {
+	writeJSON(w, http.StatusOK, envelope{
+		Success:    true,
+		Errors:     []cloudflare.ResponseInfo{},
+		Messages:   []cloudflare.ResponseInfo{},
+		Result:     result,
+		ResultInfo: info,
+	})
+}
+
+// writeError writes a failed response with a single error.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeJSON(w, status, envelope{
+		Errors:   []cloudflare.ResponseInfo{{Code: code, Message: message}},
+		Messages: []cloudflare.ResponseInfo{},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, what string) {
+	writeError(w, http.StatusNotFound, 1003, fmt.Sprintf("%s not found", what))
+}
+
+func writeBadPagination(w http.ResponseWriter) {
+	writeError(w, http.StatusBadRequest, 1001, "Invalid page or per_page")
+}
+
+// decodeBody decodes the JSON request body into v, writing an error response
+// and returning false if it is malformed.
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, 6007, "Malformed JSON in request body")
+		return false
+	}
+	return true
+}
+
+// filter returns the objects whose fields equal the values of the given query
+// parameters. Parameters that are not set are ignored.
+func filter(objs []object, query url.Values, fields ...string) []object {
+	out := []object{}
+next:
+	for _, o := range objs {
+		for _, field := range fields {
+			if v := query.Get(field); v != "" && fmt.Sprint(o[field]) != v {
+				continue next
+			}
+		}
+		out = append(out, o)
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package cftest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a server and a client for it that retries without
+// waiting.
+func newTestClient(t *testing.T) (*Server, *cloudflare.API) {
+	srv := NewServer()
+	api, err := srv.Client(cloudflare.UsingRetryPolicy(3, 0, 0))
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return srv, api
+}
+
+func TestServer_RequiresCredentials(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/zones")
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestServer_UnknownRoute(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	_, err := api.Raw("GET", "/nonexistent", nil)
+	assert.True(t, cloudflare.IsNotFound(err))
+
+	_, err = api.Raw("PATCH", "/zones", nil)
+	if apiErr, ok := errors.Cause(err).(*cloudflare.APIError); assert.True(t, ok) {
+		assert.Equal(t, http.StatusMethodNotAllowed, apiErr.StatusCode)
+	}
+}
+
+func TestServer_FaultRateLimited(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	srv.InjectFault(Fault{Method: "GET", Path: "/zones", StatusCode: http.StatusTooManyRequests, Times: 2})
+
+	_, err := api.ListZones()
+	assert.NoError(t, err)
+	assert.Len(t, srv.Requests(), 3)
+}
+
+func TestServer_FaultServerErrorExhaustsRetries(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.InjectFault(Fault{Path: "/zones/*/dns_records", StatusCode: http.StatusServiceUnavailable})
+
+	_, err := api.DNSRecords(zone.ID, cloudflare.DNSRecord{})
+	if apiErr, ok := errors.Cause(err).(*cloudflare.APIError); assert.True(t, ok) {
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.True(t, apiErr.Retried)
+	}
+	assert.Len(t, srv.Requests(), 4)
+
+	srv.ClearFaults()
+	_, err = api.DNSRecords(zone.ID, cloudflare.DNSRecord{})
+	assert.NoError(t, err)
+}
+
+func TestServer_FaultRetryAfter(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.InjectFault(Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second, Times: 1})
+
+	api, err := srv.Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+	req, _ := http.NewRequest("GET", srv.URL+"/zones", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "7", resp.Header.Get("Retry-After"))
+	}
+
+	_, err = api.ListZones()
+	assert.NoError(t, err)
+}
+
+func TestServer_FaultLatency(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	srv.InjectFault(Fault{Latency: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := api.ListZonesContext(ctx)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -0,0 +1,204 @@
+package cftest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// object is a stored resource in its JSON form. Keeping resources as JSON
+// objects rather than typed structs lets the server echo back fields it does
+// not know about, as the real API does.
+type object map[string]interface{}
+
+// id returns the "id" field of the object.
+func (o object) id() string {
+	id, _ := o["id"].(string)
+	return id
+}
+
+// str returns the string field key, or "" if it is missing or not a string.
+func (o object) str(key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// number returns the numeric field key, or 0 if it is missing or not a
+// number. Fields decoded from requests are float64 while those set by the
+// server may be int.
+func (o object) number(key string) float64 {
+	switch v := o[key].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return 0
+}
+
+// merge copies the fields of patch into o. Null fields are skipped, as the
+// client sends null for fields it does not set.
+func (o object) merge(patch object) {
+	for k, v := range patch {
+		if v != nil {
+			o[k] = v
+		}
+	}
+}
+
+// copy returns a shallow copy of o, whose top-level fields can be changed
+// without changing o.
+func (o object) copy() object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
+
+// collection is an insertion-ordered set of objects keyed by ID.
+type collection struct {
+	order []string
+	items map[string]object
+}
+
+func newCollection() *collection {
+	return &collection{items: make(map[string]object)}
+}
+
+// list returns copies of the objects in insertion order.
+func (c *collection) list() []object {
+	objs := make([]object, 0, len(c.order))
+	for _, id := range c.order {
+		objs = append(objs, c.items[id].copy())
+	}
+	return objs
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, ok := c.items[id]
+	return o, ok
+}
+
+// put adds or replaces an object. Replaced objects keep their position.
+func (c *collection) put(o object) {
+	id := o.id()
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = o
+}
+
+func (c *collection) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+	delete(c.items, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// newID returns a random 32 character hex identifier like those the API
+// assigns.
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// timestamp returns the current time in the format used by the API.
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// toObject converts a typed resource into its stored form.
+func toObject(v interface{}) object {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var o object
+	if err := json.Unmarshal(b, &o); err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// fromObjects decodes stored objects into the slice dst points to.
+func fromObjects(objs []object, dst interface{}) {
+	b, err := json.Marshal(objs)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		panic(err)
+	}
+}
+
+// fromObject decodes a stored object into the value dst points to.
+func fromObject(o object, dst interface{}) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		panic(err)
+	}
+}
+
+// pageSize holds the default and maximum per_page of a list endpoint.
+type pageSize struct {
+	def, max int
+}
+
+// paginate returns the page of objs requested by the page and per_page query
+// parameters, along with the matching result_info. It returns false if the
+// parameters are out of range.
+func paginate(objs []object, query url.Values, size pageSize) ([]object, cloudflare.ResultInfo, bool) {
+	page, perPage := 1, size.def
+	if v := query.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, cloudflare.ResultInfo{}, false
+		}
+		page = n
+	}
+	if v := query.Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > size.max {
+			return nil, cloudflare.ResultInfo{}, false
+		}
+		perPage = n
+	}
+
+	start := (page - 1) * perPage
+	if start > len(objs) {
+		start = len(objs)
+	}
+	end := start + perPage
+	if end > len(objs) {
+		end = len(objs)
+	}
+
+	info := cloudflare.ResultInfo{
+		Page:       page,
+		PerPage:    perPage,
+		Count:      end - start,
+		Total:      len(objs),
+		TotalPages: int(math.Ceil(float64(len(objs)) / float64(perPage))),
+	}
+	return objs[start:end], info, true
+}
@@ -0,0 +1,336 @@
+package cftest
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var kvNamespacePageSize = pageSize{def: 20, max: 100}
+
+// workerRouteScope is shared by the single-script filters endpoints and the
+// multi-script routes endpoints, which manage the same routes.
+func workerRouteScope(zoneID string) string {
+	return "zones/" + zoneID + "/workers/routes"
+}
+
+func (s *Server) registerWorkerRoutes() {
+	for _, kind := range []string{"filters", "routes"} {
+		s.handle("GET", "/zones/:zone/workers/"+kind, s.listWorkerRoutes)
+		s.handle("POST", "/zones/:zone/workers/"+kind, s.createWorkerRoute)
+		s.handle("GET", "/zones/:zone/workers/"+kind+"/:id", s.getWorkerRoute)
+		s.handle("PUT", "/zones/:zone/workers/"+kind+"/:id", s.updateWorkerRoute)
+		s.handle("DELETE", "/zones/:zone/workers/"+kind+"/:id", s.deleteWorkerRoute)
+	}
+}
+
+func (s *Server) listWorkerRoutes(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	writeResult(w, s.collection(workerRouteScope(p["zone"])).list())
+}
+
+func (s *Server) createWorkerRoute(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	var route object
+	if !decodeBody(w, r, &route) {
+		return
+	}
+	route["id"] = newID()
+	c := s.collection(workerRouteScope(p["zone"]))
+	if !validateWorkerRoute(w, c, route) {
+		return
+	}
+	c.put(route)
+	writeResult(w, route.copy())
+}
+
+func (s *Server) getWorkerRoute(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	route, ok := s.collection(workerRouteScope(p["zone"])).get(p["id"])
+	if !ok {
+		writeWorkerRouteNotFound(w)
+		return
+	}
+	writeResult(w, route.copy())
+}
+
+func (s *Server) updateWorkerRoute(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	c := s.collection(workerRouteScope(p["zone"]))
+	if _, ok := c.get(p["id"]); !ok {
+		writeWorkerRouteNotFound(w)
+		return
+	}
+	var route object
+	if !decodeBody(w, r, &route) {
+		return
+	}
+	route["id"] = p["id"]
+	if !validateWorkerRoute(w, c, route) {
+		return
+	}
+	c.put(route)
+	writeResult(w, route.copy())
+}
+
+func (s *Server) deleteWorkerRoute(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	if !s.collection(workerRouteScope(p["zone"])).remove(p["id"]) {
+		writeWorkerRouteNotFound(w)
+		return
+	}
+	writeResult(w, object{"id": p["id"]})
+}
+
+func writeWorkerRouteNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, 10005, "Route not found")
+}
+
+// validateWorkerRoute checks that route has a pattern no other route in c
+// has, writing an error response and returning false if not.
+func validateWorkerRoute(w http.ResponseWriter, c *collection, route object) bool {
+	pattern := route.str("pattern")
+	if pattern == "" {
+		writeError(w, http.StatusBadRequest, 10021, "Route pattern is required")
+		return false
+	}
+	for _, other := range c.list() {
+		if other.id() != route.id() && other.str("pattern") == pattern {
+			writeError(w, http.StatusConflict, 10020, "A route with this pattern already exists")
+			return false
+		}
+	}
+	return true
+}
+
+func kvNamespaceScope(accountID string) string {
+	return "accounts/" + accountID + "/storage/kv/namespaces"
+}
+
+func (s *Server) registerWorkersKVRoutes() {
+	const ns = "/accounts/:account/storage/kv/namespaces"
+	s.handle("GET", ns, s.listKVNamespaces)
+	s.handle("POST", ns, s.createKVNamespace)
+	s.handle("PUT", ns+"/:ns", s.renameKVNamespace)
+	s.handle("DELETE", ns+"/:ns", s.deleteKVNamespace)
+	s.handle("GET", ns+"/:ns/keys", s.listKVKeys)
+	s.handle("GET", ns+"/:ns/values/:key", s.readKV)
+	s.handle("PUT", ns+"/:ns/values/:key", s.writeKV)
+	s.handle("DELETE", ns+"/:ns/values/:key", s.deleteKV)
+	s.handle("PUT", ns+"/:ns/bulk", s.writeKVBulk)
+	s.handle("DELETE", ns+"/:ns/bulk", s.deleteKVBulk)
+}
+
+func (s *Server) listKVNamespaces(w http.ResponseWriter, r *http.Request, p params) {
+	page, info, ok := paginate(s.collection(kvNamespaceScope(p["account"])).list(), r.URL.Query(), kvNamespacePageSize)
+	if !ok {
+		writeBadPagination(w)
+		return
+	}
+	writePage(w, page, info)
+}
+
+func (s *Server) createKVNamespace(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Title string `json:"title"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	c := s.collection(kvNamespaceScope(p["account"]))
+	if !validateKVNamespaceTitle(w, c, body.Title) {
+		return
+	}
+	ns := object{"id": newID(), "title": body.Title}
+	c.put(ns)
+	s.kv[ns.id()] = make(map[string][]byte)
+	writeResult(w, ns.copy())
+}
+
+func (s *Server) renameKVNamespace(w http.ResponseWriter, r *http.Request, p params) {
+	ns, ok := s.kvNamespace(w, p)
+	if !ok {
+		return
+	}
+	var body struct {
+		Title string `json:"title"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Title != ns.str("title") && !validateKVNamespaceTitle(w, s.collection(kvNamespaceScope(p["account"])), body.Title) {
+		return
+	}
+	ns["title"] = body.Title
+	writeResult(w, nil)
+}
+
+func (s *Server) deleteKVNamespace(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	s.collection(kvNamespaceScope(p["account"])).remove(p["ns"])
+	delete(s.kv, p["ns"])
+	writeResult(w, nil)
+}
+
+// kvNamespace returns the namespace named by the route, writing an error
+// response and returning false if it does not exist.
+func (s *Server) kvNamespace(w http.ResponseWriter, p params) (object, bool) {
+	ns, ok := s.collection(kvNamespaceScope(p["account"])).get(p["ns"])
+	if !ok {
+		writeError(w, http.StatusNotFound, 10013, "namespace not found")
+	}
+	return ns, ok
+}
+
+func validateKVNamespaceTitle(w http.ResponseWriter, c *collection, title string) bool {
+	if title == "" {
+		writeError(w, http.StatusBadRequest, 10019, "a namespace title is required")
+		return false
+	}
+	for _, ns := range c.list() {
+		if ns.str("title") == title {
+			writeError(w, http.StatusBadRequest, 10014, "a namespace with this account ID and title already exists")
+			return false
+		}
+	}
+	return true
+}
+
+// listKVKeys lists keys in lexicographic order. Like the API it pages with an
+// opaque cursor rather than page numbers.
+func (s *Server) listKVKeys(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	query := r.URL.Query()
+	limit := 1000
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 10 || n > 1000 {
+			writeError(w, http.StatusBadRequest, 10026, "limit must be between 10 and 1000")
+			return
+		}
+		limit = n
+	}
+	start := 0
+	if v := query.Get("cursor"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, 10027, "invalid cursor")
+			return
+		}
+		start = n
+	}
+
+	var names []string
+	for name := range s.kv[p["ns"]] {
+		if strings.HasPrefix(name, query.Get("prefix")) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	keys := []object{}
+	for i := start; i < len(names) && len(keys) < limit; i++ {
+		keys = append(keys, object{"name": names[i]})
+	}
+	cursor := ""
+	if next := start + len(keys); next < len(names) {
+		cursor = strconv.Itoa(next)
+	}
+	writeResultWithInfo(w, keys, object{"count": len(keys), "cursor": cursor})
+}
+
+// readKV responds with the raw value, not a JSON envelope.
+func (s *Server) readKV(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	value, ok := s.kv[p["ns"]][p["key"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, 10009, "get: 'key not found'")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(value)
+}
+
+func (s *Server) writeKV(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	value, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 10000, "failed to read request body")
+		return
+	}
+	s.kv[p["ns"]][p["key"]] = value
+	writeResult(w, nil)
+}
+
+func (s *Server) deleteKV(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	delete(s.kv[p["ns"]], p["key"])
+	writeResult(w, nil)
+}
+
+func (s *Server) writeKVBulk(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	var pairs []struct {
+		Key    string `json:"key"`
+		Value  string `json:"value"`
+		Base64 bool   `json:"base64"`
+	}
+	if !decodeBody(w, r, &pairs) {
+		return
+	}
+	values := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		value := []byte(pair.Value)
+		if pair.Base64 {
+			var err error
+			if value, err = base64.StdEncoding.DecodeString(pair.Value); err != nil {
+				writeError(w, http.StatusBadRequest, 10021, "invalid base64 value for key "+pair.Key)
+				return
+			}
+		}
+		values[pair.Key] = value
+	}
+	for key, value := range values {
+		s.kv[p["ns"]][key] = value
+	}
+	writeResult(w, nil)
+}
+
+func (s *Server) deleteKVBulk(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.kvNamespace(w, p); !ok {
+		return
+	}
+	var keys []string
+	if !decodeBody(w, r, &keys) {
+		return
+	}
+	for _, key := range keys {
+		delete(s.kv[p["ns"]], key)
+	}
+	writeResult(w, nil)
+}
@@ -0,0 +1,96 @@
+package cftest
+
+import (
+	"context"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerRoutes(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+
+	res, err := api.CreateWorkerRoute(zone.ID, cloudflare.WorkerRoute{Pattern: "example.com/*", Enabled: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	id := res.WorkerRoute.ID
+	assert.Len(t, id, 32)
+
+	_, err = api.CreateWorkerRoute(zone.ID, cloudflare.WorkerRoute{Pattern: "example.com/*", Enabled: true})
+	assert.True(t, cloudflare.IsConflict(err))
+
+	_, err = api.UpdateWorkerRoute(zone.ID, id, cloudflare.WorkerRoute{Pattern: "example.com/api/*", Enabled: false})
+	assert.NoError(t, err)
+
+	routes, err := api.ListWorkerRoutes(zone.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, routes.Routes, 1) {
+		assert.Equal(t, "example.com/api/*", routes.Routes[0].Pattern)
+		assert.False(t, routes.Routes[0].Enabled)
+	}
+
+	_, err = api.DeleteWorkerRoute(zone.ID, id)
+	assert.NoError(t, err)
+	routes, err = api.ListWorkerRoutes(zone.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, routes.Routes)
+}
+
+func TestWorkersKV(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	api, err := srv.Client(cloudflare.UsingAccount("01a7362d577a6c3019a474fd6f485823"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	ctx := context.Background()
+
+	ns, err := api.CreateWorkersKVNamespace(ctx, &cloudflare.WorkersKVNamespaceRequest{Title: "cache"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	nsID := ns.Result.ID
+	assert.Len(t, nsID, 32)
+
+	_, err = api.CreateWorkersKVNamespace(ctx, &cloudflare.WorkersKVNamespaceRequest{Title: "cache"})
+	assert.Error(t, err)
+
+	_, err = api.UpdateWorkersKVNamespace(ctx, nsID, &cloudflare.WorkersKVNamespaceRequest{Title: "sessions"})
+	assert.NoError(t, err)
+	namespaces, err := api.ListWorkersKVNamespaces(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, namespaces.Result, 1) {
+		assert.Equal(t, "sessions", namespaces.Result[0].Title)
+	}
+
+	_, err = api.WriteWorkersKV(ctx, nsID, "user/1", []byte("alice"))
+	assert.NoError(t, err)
+	_, err = api.WriteWorkersKVBulk(ctx, nsID, cloudflare.WorkersKVBulkWriteRequest{
+		{Key: "user/2", Value: "bob"},
+		{Key: "config", Value: "{}"},
+	})
+	assert.NoError(t, err)
+
+	value, err := api.ReadWorkersKV(ctx, nsID, "user/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", string(value))
+
+	keys, err := api.ListWorkersKVs(ctx, nsID)
+	assert.NoError(t, err)
+	assert.Equal(t, []cloudflare.StorageKey{{Name: "config"}, {Name: "user/1"}, {Name: "user/2"}}, keys.Result)
+
+	_, err = api.DeleteWorkersKV(ctx, nsID, "user/1")
+	assert.NoError(t, err)
+	_, err = api.ReadWorkersKV(ctx, nsID, "user/1")
+	assert.True(t, cloudflare.IsNotFound(err))
+
+	_, err = api.DeleteWorkersKVNamespace(ctx, nsID)
+	assert.NoError(t, err)
+	_, err = api.ReadWorkersKV(ctx, nsID, "user/2")
+	assert.True(t, cloudflare.IsNotFound(err))
+}
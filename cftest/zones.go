@@ -0,0 +1,486 @@
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+var (
+	zonePageSize = pageSize{def: 20, max: 50}
+	dnsPageSize  = pageSize{def: 20, max: 100}
+)
+
+// AddZone stores zone as if it had been created through the API and returns
+// it. An ID is assigned if zone has none, and the status defaults to
+// "active".
+func (s *Server) AddZone(zone cloudflare.Zone) cloudflare.Zone {
+	if zone.ID == "" {
+		zone.ID = newID()
+	}
+	if zone.Status == "" {
+		zone.Status = "active"
+	}
+	if zone.Type == "" {
+		zone.Type = "full"
+	}
+	now := time.Now().UTC()
+	if zone.CreatedOn.IsZero() {
+		zone.CreatedOn = now
+	}
+	if zone.ModifiedOn.IsZero() {
+		zone.ModifiedOn = now
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.zones.put(toObject(zone))
+	return zone
+}
+
+// Zones returns the zones stored by the server.
+func (s *Server) Zones() []cloudflare.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var zones []cloudflare.Zone
+	fromObjects(s.zones.list(), &zones)
+	return zones
+}
+
+// AddDNSRecord stores rr in the zone with the given ID as if it had been
+// created through the API and returns it. It panics if the zone does not
+// exist.
+func (s *Server) AddDNSRecord(zoneID string, rr cloudflare.DNSRecord) cloudflare.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	zone, ok := s.zones.get(zoneID)
+	if !ok {
+		panic(fmt.Sprintf("cftest: zone %s does not exist", zoneID))
+	}
+	o := toObject(rr)
+	if rr.ID == "" {
+		o["id"] = newID()
+	}
+	newDNSRecord(o, zone)
+	s.collection(dnsScope(zoneID)).put(o)
+
+	var stored cloudflare.DNSRecord
+	fromObject(o, &stored)
+	return stored
+}
+
+// DNSRecords returns the DNS records stored in the zone with the given ID.
+func (s *Server) DNSRecords(zoneID string) []cloudflare.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []cloudflare.DNSRecord
+	fromObjects(s.collection(dnsScope(zoneID)).list(), &records)
+	return records
+}
+
+func (s *Server) registerZoneRoutes() {
+	s.handle("GET", "/zones", s.listZones)
+	s.handle("POST", "/zones", s.createZone)
+	s.handle("GET", "/zones/:zone", s.getZone)
+	s.handle("PATCH", "/zones/:zone", s.editZone)
+	s.handle("DELETE", "/zones/:zone", s.deleteZone)
+}
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request, p params) {
+	query := r.URL.Query()
+	zones := filter(s.zones.list(), query, "name", "status")
+	if accountID := query.Get("account.id"); accountID != "" {
+		var matched []object
+		for _, z := range zones {
+			if account, _ := z["account"].(map[string]interface{}); account["id"] == accountID {
+				matched = append(matched, z)
+			}
+		}
+		zones = append([]object{}, matched...)
+	}
+
+	page, info, ok := paginate(zones, query, zonePageSize)
+	if !ok {
+		writeBadPagination(w)
+		return
+	}
+	writePage(w, page, info)
+}
+
+func (s *Server) createZone(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Name    string              `json:"name"`
+		Type    string              `json:"type"`
+		Account *cloudflare.Account `json:"organization"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	name := strings.TrimSuffix(strings.ToLower(body.Name), ".")
+	if !strings.Contains(name, ".") {
+		writeError(w, http.StatusBadRequest, 1097, "Invalid domain name")
+		return
+	}
+	for _, z := range s.zones.list() {
+		if z.str("name") == name {
+			writeError(w, http.StatusBadRequest, 1061, fmt.Sprintf("%s already exists", name))
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	zone := cloudflare.Zone{
+		ID:          newID(),
+		Name:        name,
+		Status:      "pending",
+		Type:        "full",
+		CreatedOn:   now,
+		ModifiedOn:  now,
+		NameServers: []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+		Plan: cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{
+			ID:   "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+			Name: "Free Website",
+		}},
+	}
+	if body.Type == "partial" || body.Type == "secondary" {
+		zone.Type = body.Type
+	}
+	if body.Account != nil {
+		zone.Account = *body.Account
+	}
+
+	o := toObject(zone)
+	s.zones.put(o)
+	writeResult(w, o.copy())
+}
+
+// zone returns the zone named by the route, writing an error response and
+// returning false if it does not exist.
+func (s *Server) zone(w http.ResponseWriter, p params) (object, bool) {
+	z, ok := s.zones.get(p["zone"])
+	if !ok {
+		writeError(w, http.StatusNotFound, 7003, fmt.Sprintf("Could not route to /zones/%s, perhaps your object identifier is invalid?", p["zone"]))
+	}
+	return z, ok
+}
+
+func (s *Server) getZone(w http.ResponseWriter, r *http.Request, p params) {
+	if z, ok := s.zone(w, p); ok {
+		writeResult(w, z.copy())
+	}
+}
+
+func (s *Server) editZone(w http.ResponseWriter, r *http.Request, p params) {
+	z, ok := s.zone(w, p)
+	if !ok {
+		return
+	}
+	var patch object
+	if !decodeBody(w, r, &patch) {
+		return
+	}
+	for _, field := range []string{"paused", "vanity_name_servers", "plan"} {
+		if v, ok := patch[field]; ok {
+			z[field] = v
+		}
+	}
+	z["modified_on"] = timestamp()
+	writeResult(w, z.copy())
+}
+
+func (s *Server) deleteZone(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	s.zones.remove(p["zone"])
+	prefix := "zones/" + p["zone"] + "/"
+	for scope := range s.collections {
+		if strings.HasPrefix(scope, prefix) {
+			delete(s.collections, scope)
+		}
+	}
+	writeResult(w, object{"id": p["zone"]})
+}
+
+func dnsScope(zoneID string) string {
+	return "zones/" + zoneID + "/dns_records"
+}
+
+func (s *Server) registerDNSRoutes() {
+	s.handle("GET", "/zones/:zone/dns_records", s.listDNSRecords)
+	s.handle("POST", "/zones/:zone/dns_records", s.createDNSRecord)
+	s.handle("GET", "/zones/:zone/dns_records/:id", s.getDNSRecord)
+	s.handle("PATCH", "/zones/:zone/dns_records/:id", s.updateDNSRecord)
+	s.handle("PUT", "/zones/:zone/dns_records/:id", s.updateDNSRecord)
+	s.handle("DELETE", "/zones/:zone/dns_records/:id", s.deleteDNSRecord)
+}
+
+func (s *Server) listDNSRecords(w http.ResponseWriter, r *http.Request, p params) {
+	zone, ok := s.zone(w, p)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	if name := query.Get("name"); name != "" {
+		query.Set("name", qualifyName(name, zone.str("name")))
+	}
+	records, ok := filterDNSRecords(s.collection(dnsScope(p["zone"])).list(), query)
+	if !ok {
+		writeError(w, http.StatusBadRequest, 1004, "DNS Validation Error")
+		return
+	}
+
+	page, info, ok := paginate(records, query, dnsPageSize)
+	if !ok {
+		writeBadPagination(w)
+		return
+	}
+	writePage(w, page, info)
+}
+
+// filterDNSRecords applies the filters, match mode and ordering of a DNS
+// record listing. It reports false if the query is invalid.
+func filterDNSRecords(records []object, query url.Values) ([]object, bool) {
+	matchAny := false
+	switch query.Get("match") {
+	case "", "all":
+	case "any":
+		matchAny = true
+	default:
+		return nil, false
+	}
+
+	var tests []func(object) bool
+	for _, field := range []string{"type", "name", "content", "proxied"} {
+		if v := query.Get(field); v != "" {
+			field := field
+			tests = append(tests, func(o object) bool { return fmt.Sprint(o[field]) == v })
+		}
+	}
+	if v := strings.ToLower(query.Get("name.contains")); v != "" {
+		tests = append(tests, func(o object) bool { return strings.Contains(o.str("name"), v) })
+	}
+	if v := strings.ToLower(query.Get("name.startswith")); v != "" {
+		tests = append(tests, func(o object) bool { return strings.HasPrefix(o.str("name"), v) })
+	}
+
+	out := []object{}
+	for _, o := range records {
+		matched := !matchAny || len(tests) == 0
+		for _, test := range tests {
+			if test(o) == matchAny {
+				matched = matchAny
+				break
+			}
+		}
+		if matched {
+			out = append(out, o)
+		}
+	}
+
+	order := query.Get("order")
+	switch order {
+	case "":
+		return out, true
+	case "type", "name", "content", "ttl", "proxied":
+	default:
+		return nil, false
+	}
+	desc := false
+	switch query.Get("direction") {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		return nil, false
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i][order], out[j][order]
+		if a == b {
+			return false
+		}
+		var less bool
+		switch av := a.(type) {
+		case bool:
+			less = !av
+		default:
+			if order == "ttl" {
+				less = out[i].number(order) < out[j].number(order)
+			} else {
+				less = fmt.Sprint(a) < fmt.Sprint(b)
+			}
+		}
+		return less != desc
+	})
+	return out, true
+}
+
+func (s *Server) createDNSRecord(w http.ResponseWriter, r *http.Request, p params) {
+	zone, ok := s.zone(w, p)
+	if !ok {
+		return
+	}
+	var rr object
+	if !decodeBody(w, r, &rr) {
+		return
+	}
+	rr["id"] = newID()
+	if !s.validateDNSRecord(w, rr, zone) {
+		return
+	}
+	newDNSRecord(rr, zone)
+	s.collection(dnsScope(p["zone"])).put(rr)
+	writeResult(w, rr.copy())
+}
+
+func (s *Server) getDNSRecord(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	rr, ok := s.collection(dnsScope(p["zone"])).get(p["id"])
+	if !ok {
+		writeError(w, http.StatusNotFound, 81044, "Record does not exist.")
+		return
+	}
+	writeResult(w, rr.copy())
+}
+
+// updateDNSRecord handles both PATCH, which changes only the fields given,
+// and PUT, which replaces the record.
+func (s *Server) updateDNSRecord(w http.ResponseWriter, r *http.Request, p params) {
+	zone, ok := s.zone(w, p)
+	if !ok {
+		return
+	}
+	c := s.collection(dnsScope(p["zone"]))
+	existing, ok := c.get(p["id"])
+	if !ok {
+		writeError(w, http.StatusNotFound, 81044, "Record does not exist.")
+		return
+	}
+	var body object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	rr := existing.copy()
+	if r.Method == "PUT" {
+		rr = object{}
+		for _, field := range []string{"id", "created_on"} {
+			rr[field] = existing[field]
+		}
+	}
+	rr.merge(body)
+	rr["id"] = existing.id()
+	if !s.validateDNSRecord(w, rr, zone) {
+		return
+	}
+	newDNSRecord(rr, zone)
+	rr["created_on"] = existing["created_on"]
+	c.put(rr)
+	writeResult(w, rr.copy())
+}
+
+func (s *Server) deleteDNSRecord(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.zone(w, p); !ok {
+		return
+	}
+	if !s.collection(dnsScope(p["zone"])).remove(p["id"]) {
+		writeError(w, http.StatusNotFound, 81044, "Record does not exist.")
+		return
+	}
+	writeResult(w, object{"id": p["id"]})
+}
+
+// validateDNSRecord checks rr the way the API does before storing it,
+// writing an error response and returning false if it is invalid.
+func (s *Server) validateDNSRecord(w http.ResponseWriter, rr, zone object) bool {
+	rrType := strings.ToUpper(rr.str("type"))
+	name := qualifyName(rr.str("name"), zone.str("name"))
+	content := rr.str("content")
+	switch {
+	case rrType == "":
+		writeError(w, http.StatusBadRequest, 9004, "DNS record type is required.")
+		return false
+	case rr.str("name") == "":
+		writeError(w, http.StatusBadRequest, 9005, "DNS name is required.")
+		return false
+	case content == "" && rr["data"] == nil:
+		writeError(w, http.StatusBadRequest, 9007, "DNS content is required.")
+		return false
+	}
+	if proxied, _ := rr["proxied"].(bool); proxied && !proxiable(rrType) {
+		writeError(w, http.StatusBadRequest, 9004, fmt.Sprintf("%s records cannot be proxied.", rrType))
+		return false
+	}
+
+	for _, other := range s.collection(dnsScope(zone.id())).list() {
+		if other.id() == rr.id() || other.str("name") != name {
+			continue
+		}
+		otherType := other.str("type")
+		if otherType == rrType && other.str("content") == content {
+			writeError(w, http.StatusBadRequest, 81057, "Record already exists.")
+			return false
+		}
+		if otherType == "CNAME" || rrType == "CNAME" {
+			writeError(w, http.StatusBadRequest, 81053, "An A, AAAA, or CNAME record with that host already exists.")
+			return false
+		}
+	}
+	return true
+}
+
+// newDNSRecord fills in the fields of rr the API sets itself.
+func newDNSRecord(rr, zone object) {
+	rrType := strings.ToUpper(rr.str("type"))
+	rr["type"] = rrType
+	rr["name"] = qualifyName(rr.str("name"), zone.str("name"))
+	rr["zone_id"] = zone.id()
+	rr["zone_name"] = zone.str("name")
+	rr["proxiable"] = proxiable(rrType)
+	if _, ok := rr["proxied"].(bool); !ok {
+		rr["proxied"] = false
+	}
+	if rr.number("ttl") == 0 {
+		rr["ttl"] = 1
+	}
+	if _, ok := rr["locked"]; !ok {
+		rr["locked"] = false
+	}
+	if _, ok := rr["meta"].(map[string]interface{}); !ok {
+		rr["meta"] = map[string]interface{}{"auto_added": false, "source": "primary"}
+	}
+	now := timestamp()
+	if _, ok := rr["created_on"].(string); !ok || rr.str("created_on") == zeroTime {
+		rr["created_on"] = now
+	}
+	rr["modified_on"] = now
+}
+
+// zeroTime is how a zero time.Time is encoded, which is what typed records
+// passed to AddDNSRecord carry if they were not given timestamps.
+const zeroTime = "0001-01-01T00:00:00Z"
+
+// qualifyName returns name as a fully qualified name within zone, as the API
+// stores record names.
+func qualifyName(name, zone string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	if name == "@" || name == "" {
+		return zone
+	}
+	if name == zone || strings.HasSuffix(name, "."+zone) {
+		return name
+	}
+	return name + "." + zone
+}
+
+func proxiable(rrType string) bool {
+	return rrType == "A" || rrType == "AAAA" || rrType == "CNAME"
+}
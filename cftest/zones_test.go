@@ -0,0 +1,173 @@
+package cftest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZones(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone, err := api.CreateZone("Example.com", false, cloudflare.Account{ID: "01a7362d577a6c3019a474fd6f485823"}, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, zone.ID, 32)
+	assert.Equal(t, "example.com", zone.Name)
+	assert.Equal(t, "pending", zone.Status)
+	assert.Equal(t, "full", zone.Type)
+	assert.False(t, zone.CreatedOn.IsZero())
+
+	_, err = api.CreateZone("example.com", false, cloudflare.Account{}, "")
+	assert.Error(t, err)
+
+	id, err := api.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, zone.ID, id)
+
+	paused := true
+	zone, err = api.EditZone(zone.ID, cloudflare.ZoneOptions{Paused: &paused})
+	assert.NoError(t, err)
+	assert.True(t, zone.Paused)
+
+	details, err := api.ZoneDetails(zone.ID)
+	assert.NoError(t, err)
+	assert.True(t, details.Paused)
+
+	_, err = api.DeleteZone(zone.ID)
+	assert.NoError(t, err)
+	_, err = api.ZoneDetails(zone.ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+	assert.Empty(t, srv.Zones())
+}
+
+func TestZones_Pagination(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	for i := 0; i < 120; i++ {
+		srv.AddZone(cloudflare.Zone{Name: fmt.Sprintf("example%03d.com", i)})
+	}
+
+	zones, err := api.ListZones()
+	assert.NoError(t, err)
+	if assert.Len(t, zones, 120) {
+		for i, z := range zones {
+			assert.Equal(t, fmt.Sprintf("example%03d.com", i), z.Name)
+		}
+	}
+
+	res, err := api.ListZonesContext(context.Background(), cloudflare.WithPagination(cloudflare.PaginationOptions{Page: 3, PerPage: 50}))
+	assert.NoError(t, err)
+	assert.Len(t, res.Result, 20)
+	assert.Equal(t, 3, res.ResultInfo.Page)
+	assert.Equal(t, 3, res.ResultInfo.TotalPages)
+	assert.Equal(t, 120, res.ResultInfo.Total)
+}
+
+func TestDNSRecords(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+
+	res, err := api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1", Proxied: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	rr := res.Result
+	assert.Len(t, rr.ID, 32)
+	assert.Equal(t, "www.example.com", rr.Name)
+	assert.Equal(t, 1, rr.TTL)
+	assert.True(t, rr.Proxiable)
+	assert.Equal(t, zone.ID, rr.ZoneID)
+	assert.Equal(t, "example.com", rr.ZoneName)
+
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1"})
+	assert.True(t, cloudflare.IsConflict(err))
+
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "CNAME", Name: "www", Content: "example.net"})
+	assert.Error(t, err)
+
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "@", Content: "v=spf1 -all", Proxied: true})
+	assert.Error(t, err)
+
+	_, err = api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "@", Content: "v=spf1 -all"})
+	assert.NoError(t, err)
+
+	err = api.UpdateDNSRecord(zone.ID, rr.ID, cloudflare.DNSRecord{Content: "192.0.2.2", Proxied: false, TTL: 300})
+	assert.NoError(t, err)
+
+	rr, err = api.DNSRecord(zone.ID, rr.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.2", rr.Content)
+	assert.Equal(t, "www.example.com", rr.Name)
+	assert.Equal(t, 300, rr.TTL)
+	assert.False(t, rr.Proxied)
+
+	records, err := api.DNSRecords(zone.ID, cloudflare.DNSRecord{Name: "www.example.com"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	records, err = api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "TXT"})
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "example.com", records[0].Name)
+	}
+
+	assert.NoError(t, api.DeleteDNSRecord(zone.ID, rr.ID))
+	_, err = api.DNSRecord(zone.ID, rr.ID)
+	assert.True(t, cloudflare.IsNotFound(err))
+	assert.Len(t, srv.DNSRecords(zone.ID), 1)
+}
+
+func TestDNSRecords_Pagination(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	for i := 0; i < 230; i++ {
+		srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: fmt.Sprintf("host%d", i), Content: "192.0.2.1"})
+	}
+
+	records, err := api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "A"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 230)
+
+	_, err = api.DNSRecords("0123456789abcdef0123456789abcdef", cloudflare.DNSRecord{})
+	assert.True(t, cloudflare.IsNotFound(err))
+}
+
+func TestDNSRecordListOptions(t *testing.T) {
+	srv, api := newTestClient(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", Proxied: true, TTL: 1})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "CNAME", Name: "blog.example.com", Content: "example.com", Proxied: true, TTL: 1})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "CNAME", Name: "www-old.example.com", Content: "example.net", TTL: 300})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "example.com", Content: "hello", TTL: 3600})
+
+	names := func(opts cloudflare.DNSListOptions) []string {
+		records, err := api.ListDNSRecords(zone.ID, opts)
+		assert.NoError(t, err)
+		var out []string
+		for _, rr := range records {
+			out = append(out, rr.Name)
+		}
+		return out
+	}
+
+	proxied := true
+	assert.Equal(t, []string{"blog.example.com"}, names(cloudflare.DNSListOptions{Type: "CNAME", Proxied: &proxied}))
+	assert.Equal(t, []string{"www.example.com", "blog.example.com", "www-old.example.com"}, names(cloudflare.DNSListOptions{Type: "CNAME", Proxied: &proxied, Match: "any"}))
+	assert.Equal(t, []string{"www.example.com", "www-old.example.com"}, names(cloudflare.DNSListOptions{NameStartsWith: "www"}))
+	assert.Equal(t, []string{"www-old.example.com"}, names(cloudflare.DNSListOptions{NameContains: "OLD"}))
+	assert.Equal(t, []string{"blog.example.com", "example.com", "www-old.example.com", "www.example.com"}, names(cloudflare.DNSListOptions{Order: "name"}))
+	assert.Equal(t, []string{"example.com", "www-old.example.com", "www.example.com", "blog.example.com"}, names(cloudflare.DNSListOptions{Order: "ttl", Direction: "desc", PerPage: 1}))
+}
@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -31,7 +30,8 @@ const (
 )
 
 // API holds the configuration for the current API client. A client should not
-// be modified concurrently.
+// be modified concurrently; to rotate credentials while the client is in use,
+// give it a CredentialProvider.
 type API struct {
 	APIKey            string
 	APIEmail          string
@@ -46,6 +46,10 @@ type API struct {
 	rateLimiter       *rate.Limiter
 	retryPolicy       RetryPolicy
 	logger            Logger
+	hooks             requestHooksChain
+
+	credentialProvider    CredentialProvider
+	paginationConcurrency int
 }
 
 // newClient provides shared logic for New and NewWithUserServiceKey
@@ -61,7 +65,8 @@ func newClient(opts ...Option) (*API, error) {
 			MinRetryDelay: time.Duration(1) * time.Second,
 			MaxRetryDelay: time.Duration(30) * time.Second,
 		},
-		logger: silentLogger,
+		logger:                silentLogger,
+		paginationConcurrency: 4,
 	}
 
 	err := api.parseOptions(opts...)
@@ -130,6 +135,24 @@ func NewWithUserServiceKey(key string, opts ...Option) (*API, error) {
 	return api, nil
 }
 
+// NewWithCredentialProvider creates a new Cloudflare v4 API client that asks
+// provider for credentials before every request. Requests use the API token
+// if the credentials include one, and the API key and email otherwise.
+func NewWithCredentialProvider(provider CredentialProvider, opts ...Option) (*API, error) {
+	if provider == nil {
+		return nil, errors.New(errNilProvider)
+	}
+
+	api, err := newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	api.credentialProvider = provider
+
+	return api, nil
+}
+
 // SetAuthType sets the authentication method (AuthKeyEmail, AuthToken, or AuthUserService).
 func (api *API) SetAuthType(authType int) {
 	api.authType = authType
@@ -137,8 +160,13 @@ func (api *API) SetAuthType(authType int) {
 
 // ZoneIDByName retrieves a zone's ID from the name.
 func (api *API) ZoneIDByName(zoneName string) (string, error) {
+	return api.ZoneIDByNameContext(context.TODO(), zoneName)
+}
+
+// ZoneIDByNameContext is like ZoneIDByName but takes a context.
+func (api *API) ZoneIDByNameContext(ctx context.Context, zoneName string) (string, error) {
 	zoneName = normalizeZoneName(zoneName)
-	res, err := api.ListZonesContext(context.TODO(), WithZoneFilter(zoneName))
+	res, err := api.ListZonesContext(ctx, WithZoneFilter(zoneName))
 	if err != nil {
 		return "", errors.Wrap(err, "ListZonesContext command failed")
 	}
@@ -172,8 +200,8 @@ func (api *API) makeRequestContext(ctx context.Context, method, uri string, para
 	return api.makeRequestWithAuthType(ctx, method, uri, params, api.authType)
 }
 
-func (api *API) makeRequestWithHeaders(method, uri string, params interface{}, headers http.Header) ([]byte, error) {
-	return api.makeRequestWithAuthTypeAndHeaders(context.TODO(), method, uri, params, api.authType, headers)
+func (api *API) makeRequestContextWithHeaders(ctx context.Context, method, uri string, params interface{}, headers http.Header) ([]byte, error) {
+	return api.makeRequestWithAuthTypeAndHeaders(ctx, method, uri, params, api.authType, headers)
 }
 
 func (api *API) makeRequestWithAuthType(ctx context.Context, method, uri string, params interface{}, authType int) ([]byte, error) {
@@ -198,88 +226,75 @@ func (api *API) makeRequestWithAuthTypeAndHeaders(ctx context.Context, method, u
 		jsonBody = nil
 	}
 
+	policy := api.retryPolicy
+	if p, ok := retryPolicyFromContext(ctx); ok {
+		policy = p
+	}
+	backoff := policy.backoff()
+	decider := policy.decider()
+
 	var resp *http.Response
 	var respErr error
 	var reqBody io.Reader
 	var respBody []byte
-	for i := 0; i <= api.retryPolicy.MaxRetries; i++ {
+	var attempt int
+	for attempt = 0; ; attempt++ {
 		if jsonBody != nil {
 			reqBody = bytes.NewReader(jsonBody)
 		}
-		if i > 0 {
-			// expect the backoff introduced here on errored requests to dominate the effect of rate limiting
-			// don't need a random component here as the rate limiter should do something similar
-			// nb time duration could truncate an arbitrary float. Since our inputs are all ints, we should be ok
-			sleepDuration := time.Duration(math.Pow(2, float64(i-1)) * float64(api.retryPolicy.MinRetryDelay))
-
-			if sleepDuration > api.retryPolicy.MaxRetryDelay {
-				sleepDuration = api.retryPolicy.MaxRetryDelay
-			}
-			// useful to do some simple logging here, maybe introduce levels later
-			api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), i, method, uri)
-			time.Sleep(sleepDuration)
-
-		}
-		err = api.rateLimiter.Wait(context.TODO())
+		err = api.rateLimiter.Wait(ctx)
 		if err != nil {
 			return nil, errors.Wrap(err, "Error caused by request rate limiting")
 		}
-		resp, respErr = api.request(ctx, method, uri, reqBody, authType, headers)
+		resp, respErr = api.request(ctx, method, uri, reqBody, authType, headers, attempt+1)
 
-		// retry if the server is rate limiting us or if it failed
-		// assumes server operations are rolled back on failure
-		if respErr != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
-			// if we got a valid http response, try to read body so we can reuse the connection
-			// see https://golang.org/pkg/net/http/#Client.Do
-			if respErr == nil {
-				respBody, err = ioutil.ReadAll(resp.Body)
-				resp.Body.Close()
-
-				respErr = errors.Wrap(err, "could not read response body")
-
-				api.logger.Printf("Request: %s %s got an error response %d: %s\n", method, uri, resp.StatusCode,
-					strings.Replace(strings.Replace(string(respBody), "\n", "", -1), "\t", "", -1))
-			} else {
-				api.logger.Printf("Error performing request: %s %s : %s \n", method, uri, respErr.Error())
-			}
-			continue
-		} else {
+		// read the whole body so the connection can be reused
+		// see https://golang.org/pkg/net/http/#Client.Do
+		if respErr == nil {
 			respBody, err = ioutil.ReadAll(resp.Body)
-			defer resp.Body.Close()
+			resp.Body.Close()
 			if err != nil {
-				return nil, errors.Wrap(err, "could not read response body")
+				respErr = errors.Wrap(err, "could not read response body")
 			}
+		}
+
+		if attempt >= policy.MaxRetries || !decider.ShouldRetry(method, resp, respErr) {
 			break
 		}
+
+		if respErr == nil {
+			api.logger.Printf("Request: %s %s got an error response %d: %s\n", method, uri, resp.StatusCode,
+				strings.Replace(strings.Replace(string(respBody), "\n", "", -1), "\t", "", -1))
+		} else {
+			api.logger.Printf("Error performing request: %s %s : %s \n", method, uri, respErr.Error())
+		}
+
+		// resp is the failed response, which lets the backoff honour any
+		// Retry-After header the API sent.
+		sleepDuration := backoff.Delay(attempt+1, resp)
+		api.hooks.onRetry(ctx, RequestEvent{
+			Method:     method,
+			URI:        uri,
+			Attempt:    attempt + 2,
+			Response:   resp,
+			StatusCode: statusCode(resp),
+			Err:        respErr,
+			RetryDelay: sleepDuration,
+		})
+		// useful to do some simple logging here, maybe introduce levels later
+		api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), attempt+1, method, uri)
+		select {
+		case <-time.After(sleepDuration):
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "operation aborted during backoff")
+		}
 	}
 	if respErr != nil {
 		return nil, respErr
 	}
 
-	switch {
-	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
-	case resp.StatusCode == http.StatusUnauthorized:
-		return nil, errors.Errorf("HTTP status %d: invalid credentials", resp.StatusCode)
-	case resp.StatusCode == http.StatusForbidden:
-		return nil, errors.Errorf("HTTP status %d: insufficient permissions", resp.StatusCode)
-	case resp.StatusCode == http.StatusServiceUnavailable,
-		resp.StatusCode == http.StatusBadGateway,
-		resp.StatusCode == http.StatusGatewayTimeout,
-		resp.StatusCode == 522,
-		resp.StatusCode == 523,
-		resp.StatusCode == 524:
-		return nil, errors.Errorf("HTTP status %d: service failure", resp.StatusCode)
-	// This isn't a great solution due to the way the `default` case is
-	// a catch all and that the `filters/validate-expr` returns a HTTP 400
-	// yet the clients need to use the HTTP body as a JSON string.
-	case resp.StatusCode == 400 && strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr"):
-		return nil, errors.Errorf("%s", respBody)
-	default:
-		var s string
-		if respBody != nil {
-			s = string(respBody)
-		}
-		return nil, errors.Errorf("HTTP status %d: content %q", resp.StatusCode, s)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(method, uri, resp, respBody, attempt > 0)
 	}
 
 	return respBody, nil
@@ -288,27 +303,36 @@ func (api *API) makeRequestWithAuthTypeAndHeaders(ctx context.Context, method, u
 // request makes a HTTP request to the given API endpoint, returning the raw
 // *http.Response, or an error if one occurred. The caller is responsible for
 // closing the response body.
-func (api *API) request(ctx context.Context, method, uri string, reqBody io.Reader, authType int, headers http.Header) (*http.Response, error) {
+//
+// attempt is the 1-based attempt number, which is passed to any RequestHooks.
+func (api *API) request(ctx context.Context, method, uri string, reqBody io.Reader, authType int, headers http.Header, attempt int) (*http.Response, error) {
 	req, err := http.NewRequest(method, api.BaseURL+uri, reqBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request creation failed")
 	}
-	req.WithContext(ctx)
+	req = req.WithContext(ctx)
 
 	combinedHeaders := make(http.Header)
 	copyHeader(combinedHeaders, api.headers)
 	copyHeader(combinedHeaders, headers)
 	req.Header = combinedHeaders
 
+	creds, err := api.credentials(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	if authType == 0 {
+		authType = creds.authType()
+	}
 	if authType&AuthKeyEmail != 0 {
-		req.Header.Set("X-Auth-Key", api.APIKey)
-		req.Header.Set("X-Auth-Email", api.APIEmail)
+		req.Header.Set("X-Auth-Key", creds.APIKey)
+		req.Header.Set("X-Auth-Email", creds.APIEmail)
 	}
 	if authType&AuthUserService != 0 {
-		req.Header.Set("X-Auth-User-Service-Key", api.APIUserServiceKey)
+		req.Header.Set("X-Auth-User-Service-Key", creds.APIUserServiceKey)
 	}
 	if authType&AuthToken != 0 {
-		req.Header.Set("Authorization", "Bearer "+api.APIToken)
+		req.Header.Set("Authorization", "Bearer "+creds.APIToken)
 	}
 
 	if api.UserAgent != "" {
@@ -319,7 +343,26 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	event := RequestEvent{
+		Method:  method,
+		URI:     uri,
+		Attempt: attempt,
+	}
+	req, err = api.hooks.beforeRequest(req, event)
+	if err != nil {
+		return nil, errors.Wrap(err, "request hook failed")
+	}
+
+	start := time.Now()
 	resp, err := api.httpClient.Do(req)
+
+	event.Request = req
+	event.Response = resp
+	event.StatusCode = statusCode(resp)
+	event.Latency = time.Since(start)
+	event.Err = err
+	api.hooks.afterResponse(req.Context(), event)
+
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request failed")
 	}
@@ -381,7 +424,12 @@ type RawResponse struct {
 // Raw makes a HTTP request with user provided params and returns the
 // result as untouched JSON.
 func (api *API) Raw(method, endpoint string, data interface{}) (json.RawMessage, error) {
-	res, err := api.makeRequest(method, endpoint, data)
+	return api.RawContext(context.TODO(), method, endpoint, data)
+}
+
+// RawContext is like Raw but takes a context.
+func (api *API) RawContext(ctx context.Context, method, endpoint string, data interface{}) (json.RawMessage, error) {
+	res, err := api.makeRequestContext(ctx, method, endpoint, data)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -401,11 +449,36 @@ type PaginationOptions struct {
 }
 
 // RetryPolicy specifies number of retries and min/max retry delays
-// This config is used when the client exponentially backs off after errored requests
+// This config is used when the client backs off after errored requests
 type RetryPolicy struct {
 	MaxRetries    int
 	MinRetryDelay time.Duration
 	MaxRetryDelay time.Duration
+	// Backoff computes the delay before each retry. If nil, a
+	// RetryAfterBackoff wrapping a FullJitterBackoff between MinRetryDelay
+	// and MaxRetryDelay is used.
+	Backoff Backoff
+	// Decider decides which failed requests are retried. If nil,
+	// DefaultRetryDecider is used.
+	Decider RetryDecider
+}
+
+// backoff returns the Backoff to use for this policy.
+func (p RetryPolicy) backoff() Backoff {
+	if p.Backoff != nil {
+		return p.Backoff
+	}
+	return RetryAfterBackoff{
+		Fallback: FullJitterBackoff{Min: p.MinRetryDelay, Max: p.MaxRetryDelay},
+	}
+}
+
+// decider returns the RetryDecider to use for this policy.
+func (p RetryPolicy) decider() RetryDecider {
+	if p.Decider != nil {
+		return p.Decider
+	}
+	return DefaultRetryDecider{}
 }
 
 // Logger defines the interface this library needs to use logging
@@ -434,3 +507,14 @@ func WithPagination(opts PaginationOptions) ReqOption {
 		opt.params.Set("per_page", strconv.Itoa(opts.PerPage))
 	}
 }
+
+// withPage is like WithPagination, but leaves any per_page set by an
+// earlier option alone if opts.PerPage is zero.
+func withPage(opts PaginationOptions) ReqOption {
+	return func(opt *reqOption) {
+		opt.params.Set("page", strconv.Itoa(opts.Page))
+		if opts.PerPage > 0 {
+			opt.params.Set("per_page", strconv.Itoa(opts.PerPage))
+		}
+	}
+}
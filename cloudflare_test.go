@@ -1,11 +1,14 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -210,6 +213,65 @@ func TestClient_RetryReturnsPersistentErrorResponse(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_ContextCancelledBeforeRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.UserDetailsContext(ctx)
+	assert.True(t, errors.Cause(err) == context.Canceled)
+	assert.Equal(t, 0, requestsReceived)
+}
+
+func TestClient_ContextDeadlineDuringRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.UserDetailsContext(ctx)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestClient_ContextCancelledDuringBackoff(t *testing.T) {
+	setup(UsingRetryPolicy(3, 10, 10), UsingRetryBackoff(ExponentialBackoff{Min: 10 * time.Second, Max: 10 * time.Second}))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.UserDetailsContext(ctx)
+	assert.True(t, errors.Cause(err) == context.DeadlineExceeded)
+	assert.Equal(t, 1, requestsReceived)
+	assert.True(t, time.Since(start) < 10*time.Second)
+}
+
 func TestZoneIDByNameWithNonUniqueZonesWithoutOrgID(t *testing.T) {
 	setup()
 	defer teardown()
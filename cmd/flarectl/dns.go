@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/urfave/cli"
@@ -184,3 +186,151 @@ func dnsDelete(c *cli.Context) {
 		return
 	}
 }
+
+func dnsImport(c *cli.Context) {
+	if err := checkFlags(c, "zone", "file"); err != nil {
+		return
+	}
+	zone := c.String("zone")
+
+	zoneID, err := api.ZoneIDByName(zone)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	in := os.Stdin
+	if file := c.String("file"); file != "-" {
+		in, err = os.Open(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error opening zone file: ", err)
+			return
+		}
+		defer in.Close()
+	}
+
+	records, err := cloudflare.ParseZoneFile(in, zone)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error parsing zone file: ", err)
+		return
+	}
+
+	report, _ := api.BatchDNS(zoneID, cloudflare.DNSBatch{Creates: records}, cloudflare.DNSBatchOptions{})
+	output := make([][]string, 0, len(records))
+	for _, result := range report.Results {
+		if result.Status != cloudflare.DNSBatchSucceeded {
+			fmt.Fprintf(os.Stderr, "Error creating %s record %s: %s\n", result.Record.Type, result.Record.Name, result.Err)
+			continue
+		}
+		output = append(output, formatDNSRecord(result.Record))
+	}
+
+	writeTable(output, "ID", "Name", "Type", "Content", "TTL", "Proxiable", "Proxy", "Locked")
+}
+
+func dnsExport(c *cli.Context) {
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	zone := c.String("zone")
+
+	zoneID, err := api.ZoneIDByName(zone)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	records, err := api.DNSRecords(zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error fetching DNS records: ", err)
+		return
+	}
+
+	out := os.Stdout
+	if file := c.String("file"); file != "" && file != "-" {
+		out, err = os.Create(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error creating zone file: ", err)
+			return
+		}
+		defer out.Close()
+	}
+
+	if err := cloudflare.WriteZoneFile(out, zone, records); err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing zone file: ", err)
+		return
+	}
+}
+
+func formatDDNSUpdate(update cloudflare.DDNSUpdate) []string {
+	status := "unchanged"
+	switch {
+	case update.Changed && update.Previous == "":
+		status = "created"
+	case update.Changed:
+		status = "updated from " + update.Previous
+	}
+	return []string{update.Type, update.Record.Name, update.IP.String(), status}
+}
+
+func dnsDDNS(c *cli.Context) {
+	if err := checkFlags(c, "zone", "name"); err != nil {
+		return
+	}
+	zone := c.String("zone")
+	name := c.String("name")
+	if name == "@" {
+		name = zone
+	} else if name != zone && !strings.HasSuffix(name, "."+zone) {
+		name = name + "." + zone
+	}
+
+	zoneID, err := api.ZoneIDByName(zone)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	source := cloudflare.DefaultPublicIPSource
+	if u := c.String("ipv4-url"); u != "" {
+		source.IPv4URL = u
+	}
+	if u := c.String("ipv6-url"); u != "" {
+		source.IPv6URL = u
+	}
+	opts := cloudflare.DDNSOptions{
+		Source:  source,
+		IPv4:    c.BoolT("ipv4"),
+		IPv6:    c.Bool("ipv6"),
+		Create:  c.Bool("create"),
+		TTL:     c.Int("ttl"),
+		Proxied: c.Bool("proxy"),
+	}
+
+	if c.Duration("interval") <= 0 {
+		updates, err := api.UpdateDDNS(zoneID, name, opts)
+		output := make([][]string, 0, len(updates))
+		for _, update := range updates {
+			output = append(output, formatDDNSUpdate(update))
+		}
+		writeTable(output, "Type", "Name", "IP", "Status")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error updating DNS record: ", err)
+		}
+		return
+	}
+
+	opts.Interval = c.Duration("interval")
+	opts.Report = func(updates []cloudflare.DDNSUpdate, err error) {
+		now := time.Now().Format(time.RFC3339)
+		for _, update := range updates {
+			fmt.Println(now, strings.Join(formatDDNSUpdate(update), " "))
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, now, "Error updating DNS record: ", err)
+		}
+	}
+	if err := api.RunDDNS(context.Background(), zoneID, name, opts); err != nil {
+		fmt.Fprintln(os.Stderr, "Error updating DNS record: ", err)
+	}
+}
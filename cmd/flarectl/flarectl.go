@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/urfave/cli"
@@ -186,7 +187,11 @@ func main() {
 						},
 						cli.StringSliceFlag{
 							Name:  "files",
-							Usage: "a list of [exact] URLs to purge",
+							Usage: "a list of [exact] URLs to purge; \"-\" reads them from stdin, one per line",
+						},
+						cli.StringSliceFlag{
+							Name:  "prefixes",
+							Usage: "a list of URL prefixes to purge (Enterprise only)",
 						},
 					},
 				},
@@ -202,6 +207,29 @@ func main() {
 						},
 					},
 				},
+				{
+					Name:   "dnssec",
+					Action: zoneDNSSEC,
+					Usage:  "DNSSEC status and DS record for a zone",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+						cli.BoolFlag{
+							Name:  "enable",
+							Usage: "enable DNSSEC",
+						},
+						cli.BoolFlag{
+							Name:  "disable",
+							Usage: "disable DNSSEC",
+						},
+						cli.BoolFlag{
+							Name:  "ds",
+							Usage: "print only the DS record",
+						},
+					},
+				},
 				{
 					Name:    "railgun",
 					Aliases: []string{"r"},
@@ -213,12 +241,186 @@ func main() {
 					Aliases: []string{"c"},
 					Action:  zoneCerts,
 					Usage:   "Custom SSL certificates for a zone",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+					},
+					Subcommands: []cli.Command{
+						{
+							Name:    "list",
+							Aliases: []string{"l"},
+							Action:  zoneCerts,
+							Usage:   "List custom SSL certificates",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+							},
+						},
+						{
+							Name:    "upload",
+							Aliases: []string{"u"},
+							Action:  zoneCertsUpload,
+							Usage:   "Check a certificate and key, and upload them in place of the certificate for the same hosts",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+								cli.StringFlag{
+									Name:  "cert",
+									Usage: "file holding the certificate, followed by any intermediates, in PEM format",
+								},
+								cli.StringFlag{
+									Name:  "key",
+									Usage: "file holding the private key in PEM format",
+								},
+								cli.StringFlag{
+									Name:  "bundle-method",
+									Usage: "certificate chain bundling: ubiquitous, optimal or force (default: detected)",
+								},
+								cli.StringFlag{
+									Name:  "replace",
+									Usage: "ID of the certificate to replace",
+								},
+								cli.BoolFlag{
+									Name:  "check-only",
+									Usage: "check the certificate and key without uploading them",
+								},
+							},
+						},
+						{
+							Name:    "expiring",
+							Aliases: []string{"e"},
+							Action:  zoneCertsExpiring,
+							Usage:   "List custom SSL certificates that expire soon",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+								cli.DurationFlag{
+									Name:  "within",
+									Usage: "list certificates that expire within this duration",
+									Value: 30 * 24 * time.Hour,
+								},
+							},
+						},
+					},
 				},
 				{
 					Name:    "keyless",
 					Aliases: []string{"k"},
 					Action:  zoneKeyless,
 					Usage:   "Keyless SSL for a zone",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+					},
+					Subcommands: []cli.Command{
+						{
+							Name:    "list",
+							Aliases: []string{"l"},
+							Action:  zoneKeyless,
+							Usage:   "List Keyless SSL configurations",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+							},
+						},
+						{
+							Name:    "create",
+							Aliases: []string{"c"},
+							Action:  zoneKeylessCreate,
+							Usage:   "Create a Keyless SSL configuration",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+								cli.StringFlag{
+									Name:  "host",
+									Usage: "hostname or IP address of the key server",
+								},
+								cli.IntFlag{
+									Name:  "port",
+									Usage: "port of the key server",
+									Value: 24008,
+								},
+								cli.StringFlag{
+									Name:  "certificate",
+									Usage: "file holding the zone's SSL certificate in PEM format",
+								},
+								cli.StringFlag{
+									Name:  "name",
+									Usage: "name of the configuration",
+								},
+								cli.StringFlag{
+									Name:  "bundle-method",
+									Usage: "certificate chain bundling: ubiquitous, optimal or force",
+								},
+							},
+						},
+						{
+							Name:    "update",
+							Aliases: []string{"u"},
+							Action:  zoneKeylessUpdate,
+							Usage:   "Update a Keyless SSL configuration",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+								cli.StringFlag{
+									Name:  "id",
+									Usage: "configuration ID",
+								},
+								cli.StringFlag{
+									Name:  "host",
+									Usage: "hostname or IP address of the key server",
+								},
+								cli.IntFlag{
+									Name:  "port",
+									Usage: "port of the key server",
+								},
+								cli.StringFlag{
+									Name:  "name",
+									Usage: "name of the configuration",
+								},
+								cli.BoolFlag{
+									Name:  "enable",
+									Usage: "enable the configuration",
+								},
+								cli.BoolFlag{
+									Name:  "disable",
+									Usage: "disable the configuration",
+								},
+							},
+						},
+						{
+							Name:    "delete",
+							Aliases: []string{"d"},
+							Action:  zoneKeylessDelete,
+							Usage:   "Delete a Keyless SSL configuration",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "zone",
+									Usage: "zone name",
+								},
+								cli.StringFlag{
+									Name:  "id",
+									Usage: "configuration ID",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -352,6 +554,86 @@ func main() {
 						},
 					},
 				},
+				{
+					Name:    "import",
+					Aliases: []string{"i"},
+					Action:  dnsImport,
+					Usage:   "Create DNS records from a BIND zone file",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+						cli.StringFlag{
+							Name:  "file",
+							Usage: "zone file to import, or - for stdin",
+						},
+					},
+				},
+				{
+					Name:    "export",
+					Aliases: []string{"e"},
+					Action:  dnsExport,
+					Usage:   "Write the DNS records of a zone as a BIND zone file",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+						cli.StringFlag{
+							Name:  "file",
+							Usage: "file to write (default: stdout)",
+						},
+					},
+				},
+				{
+					Name:   "ddns",
+					Action: dnsDDNS,
+					Usage:  "Point A and AAAA records at this host's public IP addresses",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "zone",
+							Usage: "zone name",
+						},
+						cli.StringFlag{
+							Name:  "name",
+							Usage: "record name",
+						},
+						cli.BoolTFlag{
+							Name:  "ipv4",
+							Usage: "update the A record",
+						},
+						cli.BoolFlag{
+							Name:  "ipv6",
+							Usage: "update the AAAA record",
+						},
+						cli.BoolFlag{
+							Name:  "create",
+							Usage: "create records that do not exist",
+						},
+						cli.IntFlag{
+							Name:  "ttl",
+							Usage: "TTL of created records",
+							Value: 1,
+						},
+						cli.BoolFlag{
+							Name:  "proxy",
+							Usage: "proxy created records through Cloudflare",
+						},
+						cli.DurationFlag{
+							Name:  "interval",
+							Usage: "keep checking at this interval instead of updating once",
+						},
+						cli.StringFlag{
+							Name:  "ipv4-url",
+							Usage: "URL that returns this host's public IPv4 address",
+						},
+						cli.StringFlag{
+							Name:  "ipv6-url",
+							Usage: "URL that returns this host's public IPv6 address",
+						},
+					},
+				},
 				{
 					Name:    "delete",
 					Aliases: []string{"d"},
@@ -1,18 +1,234 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/urfave/cli"
 )
 
-func zoneCerts(*cli.Context) {
+func zoneCerts(c *cli.Context) {
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	certs, err := api.ListSSL(zoneID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error listing custom SSL certificates: ", err)
+		return
+	}
+	output := make([][]string, 0, len(certs))
+	for _, cert := range certs {
+		output = append(output, formatCustomSSL(cert))
+	}
+	writeTable(output, "ID", "Hosts", "Issuer", "Status", "Bundle Method", "Expires On")
+}
+
+func zoneCertsUpload(c *cli.Context) {
+	if err := checkFlags(c, "cert", "key"); err != nil {
+		return
+	}
+	cert, err := ioutil.ReadFile(c.String("cert"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading certificate: ", err)
+		return
+	}
+	key, err := ioutil.ReadFile(c.String("key"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading private key: ", err)
+		return
+	}
+
+	if c.Bool("check-only") {
+		info, err := cloudflare.CheckSSLCertificate(string(cert), string(key), cloudflare.SSLCertificateCheckOptions{})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error checking certificate: ", err)
+			return
+		}
+		writeTable([][]string{{
+			strings.Join(info.Hosts, ", "),
+			info.Issuer,
+			info.KeyType,
+			fmt.Sprintf("%t", info.Trusted),
+			info.BundleMethod,
+			info.NotAfter.Format(time.RFC3339),
+		}}, "Hosts", "Issuer", "Key Type", "Trusted", "Bundle Method", "Expires On")
+		return
+	}
+
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	uploaded, _, err := api.UploadSSL(zoneID, cloudflare.ZoneCustomSSLOptions{
+		Certificate:  string(cert),
+		PrivateKey:   string(key),
+		BundleMethod: c.String("bundle-method"),
+	}, cloudflare.SSLUploadOptions{Replace: c.String("replace")})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error uploading custom SSL certificate: ", err)
+		return
+	}
+	writeTable([][]string{formatCustomSSL(uploaded)}, "ID", "Hosts", "Issuer", "Status", "Bundle Method", "Expires On")
+}
+
+func zoneCertsExpiring(c *cli.Context) {
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	certs, err := api.ListSSL(zoneID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error listing custom SSL certificates: ", err)
+		return
+	}
+	deadline := time.Now().Add(c.Duration("within"))
+	output := make([][]string, 0, len(certs))
+	for _, cert := range certs {
+		if cert.ExpiresOn.Before(deadline) {
+			output = append(output, formatCustomSSL(cert))
+		}
+	}
+	writeTable(output, "ID", "Hosts", "Issuer", "Status", "Bundle Method", "Expires On")
+}
+
+func formatCustomSSL(cert cloudflare.ZoneCustomSSL) []string {
+	return []string{
+		cert.ID,
+		strings.Join(cert.Hosts, ", "),
+		cert.Issuer,
+		cert.Status,
+		cert.BundleMethod,
+		cert.ExpiresOn.Format(time.RFC3339),
+	}
+}
+
+func zoneKeyless(c *cli.Context) {
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	keyless, err := api.ListKeyless(zoneID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error listing Keyless SSL configurations: ", err)
+		return
+	}
+	output := make([][]string, 0, len(keyless))
+	for _, k := range keyless {
+		output = append(output, formatKeyless(k))
+	}
+	writeTable(output, "ID", "Name", "Host", "Port", "Status", "Enabled")
+}
+
+func zoneKeylessCreate(c *cli.Context) {
+	if err := checkFlags(c, "zone", "host", "certificate"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	cert, err := ioutil.ReadFile(c.String("certificate"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading certificate: ", err)
+		return
+	}
+
+	keyless, err := api.CreateKeyless(zoneID, cloudflare.KeylessSSLOptions{
+		Host:         c.String("host"),
+		Port:         c.Int("port"),
+		Certificate:  string(cert),
+		Name:         c.String("name"),
+		BundleMethod: c.String("bundle-method"),
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error creating Keyless SSL configuration: ", err)
+		return
+	}
+	writeTable([][]string{formatKeyless(keyless)}, "ID", "Name", "Host", "Port", "Status", "Enabled")
 }
 
-func zoneKeyless(*cli.Context) {
+func zoneKeylessUpdate(c *cli.Context) {
+	if err := checkFlags(c, "zone", "id"); err != nil {
+		return
+	}
+	if c.Bool("enable") && c.Bool("disable") {
+		fmt.Fprintln(os.Stderr, "Only one of --enable and --disable can be given")
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	options := cloudflare.KeylessSSLUpdateOptions{
+		Host: c.String("host"),
+		Port: c.Int("port"),
+		Name: c.String("name"),
+	}
+	if c.Bool("enable") || c.Bool("disable") {
+		enabled := c.Bool("enable")
+		options.Enabled = &enabled
+	}
+	keyless, err := api.UpdateKeyless(zoneID, c.String("id"), options)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error updating Keyless SSL configuration: ", err)
+		return
+	}
+	writeTable([][]string{formatKeyless(keyless)}, "ID", "Name", "Host", "Port", "Status", "Enabled")
+}
+
+func zoneKeylessDelete(c *cli.Context) {
+	if err := checkFlags(c, "zone", "id"); err != nil {
+		return
+	}
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	if err := api.DeleteKeyless(zoneID, c.String("id")); err != nil {
+		fmt.Fprintln(os.Stderr, "Error deleting Keyless SSL configuration: ", err)
+	}
+}
+
+func formatKeyless(k cloudflare.KeylessSSL) []string {
+	return []string{
+		k.ID,
+		k.Name,
+		k.Host,
+		strconv.Itoa(k.Port),
+		k.Status,
+		fmt.Sprintf("%t", k.Enabled),
+	}
 }
 
 func zoneRailgun(*cli.Context) {
@@ -194,47 +410,83 @@ func zoneCachePurge(c *cli.Context) {
 		return
 	}
 
-	var resp cloudflare.PurgeCacheResponse
-
 	// Purge everything
 	if c.Bool("everything") {
-		resp, err = api.PurgeEverything(zoneID)
+		resp, err := api.PurgeEverything(zoneID)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error purging all from zone %q: %s\n", zoneName, err)
 			return
 		}
-	} else {
-		var (
-			files = c.StringSlice("files")
-			tags  = c.StringSlice("tags")
-			hosts = c.StringSlice("hosts")
-		)
-
-		if len(files) == 0 && len(tags) == 0 && len(hosts) == 0 {
-			fmt.Fprintln(os.Stderr, "You must provide at least one of the --files, --tags or --hosts flags")
-			return
-		}
+		writeTable([][]string{formatCacheResponse(resp)}, "ID")
+		return
+	}
 
-		// Purge selectively
-		purgeReq := cloudflare.PurgeCacheRequest{
-			Files: c.StringSlice("files"),
-			Tags:  c.StringSlice("tags"),
-			Hosts: c.StringSlice("hosts"),
-		}
+	var (
+		files    = c.StringSlice("files")
+		tags     = c.StringSlice("tags")
+		hosts    = c.StringSlice("hosts")
+		prefixes = c.StringSlice("prefixes")
+	)
 
-		resp, err = api.PurgeCache(zoneID, purgeReq)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error purging the cache from zone %q: %s\n", zoneName, err)
-			return
-		}
+	// A file of "-" reads more from stdin, one per line
+	files, err = expandStdin(files)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading files from stdin: ", err)
+		return
 	}
 
-	output := make([][]string, 0, 1)
-	output = append(output, formatCacheResponse(resp))
+	if len(files) == 0 && len(tags) == 0 && len(hosts) == 0 && len(prefixes) == 0 {
+		fmt.Fprintln(os.Stderr, "You must provide at least one of the --files, --tags, --hosts or --prefixes flags")
+		return
+	}
+
+	// Purge selectively, in as many requests as the API's limits need
+	purgeReq := cloudflare.PurgeCacheRequest{
+		Files:    files,
+		Tags:     tags,
+		Hosts:    hosts,
+		Prefixes: prefixes,
+	}
+
+	report, err := api.BatchPurgeCache(zoneID, purgeReq, cloudflare.PurgeCacheOptions{})
+	if err != nil && len(report.Chunks) == 0 {
+		fmt.Fprintf(os.Stderr, "Error purging the cache from zone %q: %s\n", zoneName, err)
+		return
+	}
+	output := make([][]string, 0, len(report.Chunks))
+	for _, chunk := range report.Chunks {
+		if chunk.Err != nil {
+			fmt.Fprintf(os.Stderr, "Error purging the cache from zone %q: %s\n", zoneName, chunk.Err)
+			continue
+		}
+		output = append(output, []string{chunk.ID})
+	}
 
 	writeTable(output, "ID")
 }
 
+// expandStdin replaces a "-" in list with the non-blank lines read from
+// stdin.
+func expandStdin(list []string) ([]string, error) {
+	var expanded []string
+	for _, item := range list {
+		if item != "-" {
+			expanded = append(expanded, item)
+			continue
+		}
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				expanded = append(expanded, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return expanded, nil
+}
+
 func zoneRecords(c *cli.Context) {
 	var zone string
 	if len(c.Args()) > 0 {
@@ -282,8 +534,10 @@ func zoneRecords(c *cli.Context) {
 		case "MX":
 			r.Content = fmt.Sprintf("%d %s", r.Priority, r.Content)
 		case "SRV":
-			dp := r.Data.(map[string]interface{})
-			r.Content = fmt.Sprintf("%.f %s", dp["priority"], r.Content)
+			var srv cloudflare.SRVRecordData
+			if err := r.DecodeData(&srv); err == nil {
+				r.Content = fmt.Sprintf("%d %s", srv.Priority, r.Content)
+			}
 			// Cloudflare's API, annoyingly, automatically prepends the weight
 			// and port into content, separated by tabs.
 			// XXX: File this as a bug. LOC doesn't do this.
@@ -301,6 +555,55 @@ func zoneRecords(c *cli.Context) {
 	writeTable(output, "ID", "Type", "Name", "Content", "Proxied", "TTL")
 }
 
+func zoneDNSSEC(c *cli.Context) {
+	if err := checkFlags(c, "zone"); err != nil {
+		return
+	}
+	if c.Bool("enable") && c.Bool("disable") {
+		fmt.Fprintln(os.Stderr, "Only one of --enable and --disable can be given")
+		return
+	}
+
+	zoneID, err := api.ZoneIDByName(c.String("zone"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var dnssec cloudflare.ZoneDNSSEC
+	errMsg := "Error fetching DNSSEC settings: "
+	switch {
+	case c.Bool("enable"):
+		dnssec, err = api.UpdateZoneDNSSEC(zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: "active"})
+		errMsg = "Error enabling DNSSEC: "
+	case c.Bool("disable"):
+		dnssec, err = api.UpdateZoneDNSSEC(zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: "disabled"})
+		errMsg = "Error disabling DNSSEC: "
+	default:
+		dnssec, err = api.ZoneDNSSECSetting(zoneID)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errMsg, err)
+		return
+	}
+
+	if c.Bool("ds") {
+		// Print just the DS record, ready to hand to the registrar.
+		fmt.Println(dnssec.DS)
+		return
+	}
+
+	output := [][]string{{
+		dnssec.Status,
+		strconv.Itoa(dnssec.KeyTag),
+		dnssec.Algorithm,
+		dnssec.DigestType,
+		dnssec.Digest,
+		dnssec.DS,
+	}}
+	writeTable(output, "Status", "Key Tag", "Algorithm", "Digest Type", "Digest", "DS Record")
+}
+
 func formatCacheResponse(resp cloudflare.PurgeCacheResponse) []string {
 	return []string{
 		resp.Result.ID,
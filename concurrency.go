@@ -0,0 +1,35 @@
+package cloudflare
+
+import "sync"
+
+// DefaultBatchConcurrency is how many requests BatchDNS, BatchPurgeCache
+// and ZoneAnalyticsRange have in flight at once unless their options say
+// otherwise. Requests are also subject to the client's rate limit, which is
+// what bounds the overall rate.
+const DefaultBatchConcurrency = 4
+
+// forEachConcurrently calls fn for each index from 0 to count-1, from up to
+// n goroutines at once, or DefaultBatchConcurrency if n < 1. It returns once
+// every call has returned. fn is typically used to fill in the i'th element
+// of a slice allocated up front.
+func forEachConcurrently(n, count int, fn func(i int)) {
+	if n < 1 {
+		n = DefaultBatchConcurrency
+	}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < n && w < count; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,41 @@
+package cloudflare
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var (
+		mu            sync.Mutex
+		inFlight, max int
+	)
+	seen := make([]bool, 20)
+	forEachConcurrently(3, len(seen), func(i int) {
+		mu.Lock()
+		inFlight++
+		if inFlight > max {
+			max = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+		seen[i] = true
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	})
+
+	for i, ok := range seen {
+		assert.True(t, ok, "index %d", i)
+	}
+	assert.True(t, max <= 3, "%d calls were in flight at once", max)
+
+	calls := 0
+	forEachConcurrently(0, 0, func(int) { calls++ })
+	assert.Equal(t, 0, calls)
+}
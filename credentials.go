@@ -0,0 +1,220 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Credentials holds the secrets used to authenticate API requests. A request
+// is authenticated with the API token if one is set, and with the API key and
+// email otherwise. Requests to the Origin CA endpoints use the user service
+// key, so set both APIToken and APIUserServiceKey to use a token for most
+// calls and the user service key for CreateOriginCertificate and friends.
+type Credentials struct {
+	APIKey            string `json:"api_key,omitempty"`
+	APIEmail          string `json:"api_email,omitempty"`
+	APIToken          string `json:"api_token,omitempty"`
+	APIUserServiceKey string `json:"api_user_service_key,omitempty"`
+}
+
+// authType returns the authentication method to use with c for requests
+// that do not ask for a particular one.
+func (c Credentials) authType() int {
+	switch {
+	case c.APIToken != "":
+		return AuthToken
+	case c.APIKey != "" && c.APIEmail != "":
+		return AuthKeyEmail
+	case c.APIUserServiceKey != "":
+		return AuthUserService
+	}
+	return 0
+}
+
+// merge returns c with its empty fields filled from other.
+func (c Credentials) merge(other Credentials) Credentials {
+	if c.APIKey == "" && c.APIEmail == "" {
+		c.APIKey, c.APIEmail = other.APIKey, other.APIEmail
+	}
+	if c.APIToken == "" {
+		c.APIToken = other.APIToken
+	}
+	if c.APIUserServiceKey == "" {
+		c.APIUserServiceKey = other.APIUserServiceKey
+	}
+	return c
+}
+
+// CredentialProvider supplies the credentials for API requests. A client with
+// a CredentialProvider asks it for credentials before every request,
+// including retries, so rotated credentials take effect without rebuilding
+// the client. Implementations must be safe for concurrent use.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc is an adapter to allow the use of an ordinary
+// function as a CredentialProvider, for example to fetch credentials from a
+// secrets manager.
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls f(ctx).
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials is a CredentialProvider that returns fixed credentials,
+// which can be replaced at any time with Set.
+type StaticCredentials struct {
+	mu    sync.RWMutex
+	creds Credentials
+}
+
+// NewStaticCredentials returns a StaticCredentials holding creds.
+func NewStaticCredentials(creds Credentials) *StaticCredentials {
+	return &StaticCredentials{creds: creds}
+}
+
+// Credentials returns the current credentials.
+func (s *StaticCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.creds, nil
+}
+
+// Set replaces the credentials. Requests that have already started are not
+// affected.
+func (s *StaticCredentials) Set(creds Credentials) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.creds = creds
+}
+
+// EnvCredentials is a CredentialProvider that reads the CF_API_TOKEN,
+// CF_API_KEY, CF_API_EMAIL and CF_API_USER_SERVICE_KEY environment variables
+// on every request.
+type EnvCredentials struct{}
+
+// Credentials returns the credentials set in the environment.
+func (EnvCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	creds := Credentials{
+		APIKey:            os.Getenv("CF_API_KEY"),
+		APIEmail:          os.Getenv("CF_API_EMAIL"),
+		APIToken:          os.Getenv("CF_API_TOKEN"),
+		APIUserServiceKey: os.Getenv("CF_API_USER_SERVICE_KEY"),
+	}
+	if creds.authType() == 0 {
+		return Credentials{}, errors.New("no credentials set in environment")
+	}
+	return creds, nil
+}
+
+// FileCredentials is a CredentialProvider that reads credentials from a file,
+// reloading it whenever it changes. This suits secrets that are mounted into a
+// container and rotated in place.
+//
+// The file holds either a JSON object with the fields of Credentials
+// ("api_token", "api_key" and so on) or just an API token.
+type FileCredentials struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	creds   Credentials
+}
+
+// NewFileCredentials returns a FileCredentials that reads the file at path.
+// The file is not read until credentials are first needed.
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{path: path}
+}
+
+// Credentials returns the credentials in the file, reading it again if it
+// has been modified since it was last read.
+func (f *FileCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "failed to read credentials file")
+	}
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size && f.creds.authType() != 0 {
+		return f.creds, nil
+	}
+
+	b, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "failed to read credentials file")
+	}
+	creds, err := parseCredentials(b)
+	if err != nil {
+		return Credentials{}, errors.Wrapf(err, "invalid credentials file %s", f.path)
+	}
+
+	f.modTime, f.size, f.creds = info.ModTime(), info.Size(), creds
+	return creds, nil
+}
+
+// parseCredentials parses the contents of a credentials file.
+func parseCredentials(b []byte) (Credentials, error) {
+	content := strings.TrimSpace(string(b))
+	var creds Credentials
+	if strings.HasPrefix(content, "{") {
+		if err := json.Unmarshal([]byte(content), &creds); err != nil {
+			return Credentials{}, err
+		}
+	} else {
+		creds.APIToken = content
+	}
+	if creds.authType() == 0 {
+		return Credentials{}, errors.New("no credentials found")
+	}
+	return creds, nil
+}
+
+// CombineCredentialProviders returns a CredentialProvider that fills in
+// credentials from each provider in turn: fields left empty by one provider
+// are taken from the next. For example, an API token from the environment can
+// be combined with a user service key from a file. An error from any provider
+// is returned.
+func CombineCredentialProviders(providers ...CredentialProvider) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		var creds Credentials
+		for _, p := range providers {
+			c, err := p.Credentials(ctx)
+			if err != nil {
+				return Credentials{}, err
+			}
+			creds = creds.merge(c)
+		}
+		return creds, nil
+	})
+}
+
+// credentials returns the credentials for a request: those from the
+// client's CredentialProvider if it has one, or else its API key, token and
+// so on.
+func (api *API) credentials(ctx context.Context) (Credentials, error) {
+	if api.credentialProvider == nil {
+		return Credentials{
+			APIKey:            api.APIKey,
+			APIEmail:          api.APIEmail,
+			APIToken:          api.APIToken,
+			APIUserServiceKey: api.APIUserServiceKey,
+		}, nil
+	}
+	creds, err := api.credentialProvider.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "failed to get credentials")
+	}
+	return creds, nil
+}
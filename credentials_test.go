@@ -0,0 +1,195 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newProviderClient returns a client for the test server that gets its
+// credentials from provider.
+func newProviderClient(t *testing.T, provider CredentialProvider) *API {
+	api, err := NewWithCredentialProvider(provider, UsingRateLimit(100000), UsingRetryPolicy(0, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	api.BaseURL = server.URL
+	return api
+}
+
+func handleUser(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+}
+
+func TestCredentialProvider_Rotation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	seen := make(map[string]int)
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.Header.Get("Authorization")]++
+		mu.Unlock()
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+		handleUser(w, r)
+	})
+
+	creds := NewStaticCredentials(Credentials{APIToken: "first"})
+	api := newProviderClient(t, creds)
+
+	_, err := api.UserDetails()
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.UserDetails()
+			assert.NoError(t, err)
+		}()
+	}
+	creds.Set(Credentials{APIToken: "second"})
+	wg.Wait()
+
+	_, err = api.UserDetails()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 12, seen["Bearer first"]+seen["Bearer second"])
+	assert.True(t, seen["Bearer first"] >= 1)
+	assert.True(t, seen["Bearer second"] >= 1)
+}
+
+func TestCredentialProvider_TokenWithUserServiceKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-Auth-User-Service-Key"))
+		handleUser(w, r)
+	})
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "v1.0-service-key", r.Header.Get("X-Auth-User-Service-Key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	api := newProviderClient(t, CombineCredentialProviders(
+		NewStaticCredentials(Credentials{APIToken: "token"}),
+		NewStaticCredentials(Credentials{APIToken: "ignored", APIUserServiceKey: "v1.0-service-key"}),
+	))
+
+	_, err := api.UserDetails()
+	assert.NoError(t, err)
+	_, err = api.OriginCertificates(OriginCACertificateListOptions{ZoneID: "023e105f4ecef8ad9ca31a8372d0c353"})
+	assert.NoError(t, err)
+}
+
+func TestCredentialProvider_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	api := newProviderClient(t, CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		return Credentials{}, errors.New("vault sealed")
+	}))
+
+	_, err := api.UserDetails()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "vault sealed")
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func TestUsingCredentialProvider(t *testing.T) {
+	creds := NewStaticCredentials(Credentials{APIKey: "rotated", APIEmail: "user@example.com"})
+	setup(UsingCredentialProvider(creds))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "rotated", r.Header.Get("X-Auth-Key"))
+		assert.Equal(t, "user@example.com", r.Header.Get("X-Auth-Email"))
+		handleUser(w, r)
+	})
+
+	_, err := client.UserDetails()
+	assert.NoError(t, err)
+}
+
+func TestNewWithCredentialProvider_Nil(t *testing.T) {
+	_, err := NewWithCredentialProvider(nil)
+	assert.EqualError(t, err, errNilProvider)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	for _, name := range []string{"CF_API_TOKEN", "CF_API_KEY", "CF_API_EMAIL", "CF_API_USER_SERVICE_KEY"} {
+		if v, ok := os.LookupEnv(name); ok {
+			defer os.Setenv(name, v)
+		} else {
+			defer os.Unsetenv(name)
+		}
+		os.Unsetenv(name)
+	}
+
+	_, err := EnvCredentials{}.Credentials(context.Background())
+	assert.Error(t, err)
+
+	os.Setenv("CF_API_KEY", "deadbeef")
+	os.Setenv("CF_API_EMAIL", "user@example.com")
+	creds, err := EnvCredentials{}.Credentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{APIKey: "deadbeef", APIEmail: "user@example.com"}, creds)
+	assert.Equal(t, AuthKeyEmail, creds.authType())
+
+	os.Setenv("CF_API_TOKEN", "token")
+	creds, err = EnvCredentials{}.Credentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, AuthToken, creds.authType())
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cloudflare")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+
+	provider := NewFileCredentials(path)
+	_, err = provider.Credentials(context.Background())
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("first-token\n"), 0600))
+	creds, err := provider.Credentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{APIToken: "first-token"}, creds)
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`{"api_token": "second-token", "api_user_service_key": "v1.0-key"}`), 0600))
+	later := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(path, later, later))
+	creds, err = provider.Credentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{APIToken: "second-token", APIUserServiceKey: "v1.0-key"}, creds)
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`{}`), 0600))
+	assert.NoError(t, os.Chtimes(path, later.Add(time.Minute), later.Add(time.Minute)))
+	_, err = provider.Credentials(context.Background())
+	assert.Error(t, err)
+}
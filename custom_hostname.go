@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -56,7 +57,28 @@ type CustomHostnameListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-update-custom-hostname-configuration
 func (api *API) UpdateCustomHostnameSSL(zoneID string, customHostnameID string, ssl CustomHostnameSSL) (CustomHostname, error) {
-	return CustomHostname{}, errors.New("Not implemented")
+	return api.UpdateCustomHostnameSSLContext(context.TODO(), zoneID, customHostnameID, ssl)
+}
+
+// UpdateCustomHostnameSSLContext is like UpdateCustomHostnameSSL but takes a
+// context.
+func (api *API) UpdateCustomHostnameSSLContext(ctx context.Context, zoneID string, customHostnameID string, ssl CustomHostnameSSL) (CustomHostname, error) {
+	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
+	params := struct {
+		SSL CustomHostnameSSL `json:"ssl"`
+	}{ssl}
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, params)
+	if err != nil {
+		return CustomHostname{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var response CustomHostnameResponse
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return CustomHostname{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return response.Result, nil
 }
 
 // DeleteCustomHostname deletes a custom hostname (and any issued SSL
@@ -64,8 +86,13 @@ func (api *API) UpdateCustomHostnameSSL(zoneID string, customHostnameID string,
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-delete-a-custom-hostname-and-any-issued-ssl-certificates-
 func (api *API) DeleteCustomHostname(zoneID string, customHostnameID string) error {
+	return api.DeleteCustomHostnameContext(context.TODO(), zoneID, customHostnameID)
+}
+
+// DeleteCustomHostnameContext is like DeleteCustomHostname but takes a context.
+func (api *API) DeleteCustomHostnameContext(ctx context.Context, zoneID string, customHostnameID string) error {
 	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -83,8 +110,13 @@ func (api *API) DeleteCustomHostname(zoneID string, customHostnameID string) err
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-create-custom-hostname
 func (api *API) CreateCustomHostname(zoneID string, ch CustomHostname) (*CustomHostnameResponse, error) {
+	return api.CreateCustomHostnameContext(context.TODO(), zoneID, ch)
+}
+
+// CreateCustomHostnameContext is like CreateCustomHostname but takes a context.
+func (api *API) CreateCustomHostnameContext(ctx context.Context, zoneID string, ch CustomHostname) (*CustomHostnameResponse, error) {
 	uri := "/zones/" + zoneID + "/custom_hostnames"
-	res, err := api.makeRequest("POST", uri, ch)
+	res, err := api.makeRequestContext(ctx, "POST", uri, ch)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -105,6 +137,11 @@ func (api *API) CreateCustomHostname(zoneID string, ch CustomHostname) (*CustomH
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-list-custom-hostnames
 func (api *API) CustomHostnames(zoneID string, page int, filter CustomHostname) ([]CustomHostname, ResultInfo, error) {
+	return api.CustomHostnamesContext(context.TODO(), zoneID, page, filter)
+}
+
+// CustomHostnamesContext is like CustomHostnames but takes a context.
+func (api *API) CustomHostnamesContext(ctx context.Context, zoneID string, page int, filter CustomHostname) ([]CustomHostname, ResultInfo, error) {
 	v := url.Values{}
 	v.Set("per_page", "50")
 	v.Set("page", strconv.Itoa(page))
@@ -114,7 +151,7 @@ func (api *API) CustomHostnames(zoneID string, page int, filter CustomHostname)
 	query := "?" + v.Encode()
 
 	uri := "/zones/" + zoneID + "/custom_hostnames" + query
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []CustomHostname{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -127,12 +164,46 @@ func (api *API) CustomHostnames(zoneID string, page int, filter CustomHostname)
 	return customHostnameListResponse.Result, customHostnameListResponse.ResultInfo, nil
 }
 
+// CustomHostnameIterator iterates over custom hostnames. See Paginator.
+type CustomHostnameIterator struct {
+	*Paginator
+}
+
+// Value returns the current custom hostname.
+func (it *CustomHostnameIterator) Value() CustomHostname {
+	v, _ := it.value().(CustomHostname)
+	return v
+}
+
+// All returns every remaining custom hostname, fetching up to concurrency pages
+// at a time.
+func (it *CustomHostnameIterator) All(concurrency int) ([]CustomHostname, error) {
+	var hostnames []CustomHostname
+	if err := it.collect(concurrency, &hostnames); err != nil {
+		return nil, err
+	}
+	return hostnames, nil
+}
+
+// CustomHostnamesIter returns an iterator over the custom hostnames of a zone,
+// filtered by filter in the same way as CustomHostnames.
+func (api *API) CustomHostnamesIter(ctx context.Context, zoneID string, filter CustomHostname) *CustomHostnameIterator {
+	return &CustomHostnameIterator{newPaginator(ctx, PaginationOptions{}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.CustomHostnamesContext(ctx, zoneID, pageOpts.Page, filter)
+	})}
+}
+
 // CustomHostname inspects the given custom hostname in the given zone.
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-custom-hostname-configuration-details
 func (api *API) CustomHostname(zoneID string, customHostnameID string) (CustomHostname, error) {
+	return api.CustomHostnameContext(context.TODO(), zoneID, customHostnameID)
+}
+
+// CustomHostnameContext is like CustomHostname but takes a context.
+func (api *API) CustomHostnameContext(ctx context.Context, zoneID string, customHostnameID string) (CustomHostname, error) {
 	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return CustomHostname{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -148,7 +219,12 @@ func (api *API) CustomHostname(zoneID string, customHostnameID string) (CustomHo
 
 // CustomHostnameIDByName retrieves the ID for the given hostname in the given zone.
 func (api *API) CustomHostnameIDByName(zoneID string, hostname string) (string, error) {
-	customHostnames, _, err := api.CustomHostnames(zoneID, 1, CustomHostname{Hostname: hostname})
+	return api.CustomHostnameIDByNameContext(context.TODO(), zoneID, hostname)
+}
+
+// CustomHostnameIDByNameContext is like CustomHostnameIDByName but takes a context.
+func (api *API) CustomHostnameIDByNameContext(ctx context.Context, zoneID string, hostname string) (string, error) {
+	customHostnames, _, err := api.CustomHostnamesContext(ctx, zoneID, 1, CustomHostname{Hostname: hostname})
 	if err != nil {
 		return "", errors.Wrap(err, "CustomHostnames command failed")
 	}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -250,3 +251,55 @@ func TestCustomHostname_CustomHostname(t *testing.T) {
 		assert.Equal(t, want, customHostname)
 	}
 }
+
+func TestCustomHostname_UpdateCustomHostnameSSL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"ssl": {"method": "http", "type": "dv", "settings": {"min_tls_version": "1.2"}}}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+"success": true,
+"result": {
+    "id": "bar",
+    "hostname": "foo.bar.com",
+    "ssl": {
+      "type": "dv",
+      "method": "http",
+      "status": "pending_validation",
+      "settings": {
+        "min_tls_version": "1.2"
+      }
+    }
+  }
+}`)
+	})
+
+	customHostname, err := client.UpdateCustomHostnameSSL("foo", "bar", CustomHostnameSSL{
+		Method:   "http",
+		Type:     "dv",
+		Settings: CustomHostnameSSLSettings{MinTLSVersion: "1.2"},
+	})
+
+	want := CustomHostname{
+		ID:       "bar",
+		Hostname: "foo.bar.com",
+		SSL: CustomHostnameSSL{
+			Status:   "pending_validation",
+			Method:   "http",
+			Type:     "dv",
+			Settings: CustomHostnameSSLSettings{MinTLSVersion: "1.2"},
+		},
+	}
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, customHostname)
+	}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -54,6 +55,11 @@ type CustomPageParameters struct {
 // Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-list-available-custom-pages
 // Account API reference: https://api.cloudflare.com/#custom-pages-account--list-custom-pages
 func (api *API) CustomPages(options *CustomPageOptions) ([]CustomPage, error) {
+	return api.CustomPagesContext(context.TODO(), options)
+}
+
+// CustomPagesContext is like CustomPages but takes a context.
+func (api *API) CustomPagesContext(ctx context.Context, options *CustomPageOptions) ([]CustomPage, error) {
 	var (
 		pageType, identifier string
 	)
@@ -77,7 +83,7 @@ func (api *API) CustomPages(options *CustomPageOptions) ([]CustomPage, error) {
 
 	uri := fmt.Sprintf("/%s/%s/custom_pages", pageType, identifier)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -96,6 +102,11 @@ func (api *API) CustomPages(options *CustomPageOptions) ([]CustomPage, error) {
 // Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
 // Account API reference: https://api.cloudflare.com/#custom-pages-account--custom-page-details
 func (api *API) CustomPage(options *CustomPageOptions, customPageID string) (CustomPage, error) {
+	return api.CustomPageContext(context.TODO(), options, customPageID)
+}
+
+// CustomPageContext is like CustomPage but takes a context.
+func (api *API) CustomPageContext(ctx context.Context, options *CustomPageOptions, customPageID string) (CustomPage, error) {
 	var (
 		pageType, identifier string
 	)
@@ -119,7 +130,7 @@ func (api *API) CustomPage(options *CustomPageOptions, customPageID string) (Cus
 
 	uri := fmt.Sprintf("/%s/%s/custom_pages/%s", pageType, identifier, customPageID)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return CustomPage{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -138,6 +149,11 @@ func (api *API) CustomPage(options *CustomPageOptions, customPageID string) (Cus
 // Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
 // Account API reference: https://api.cloudflare.com/#custom-pages-account--update-custom-page
 func (api *API) UpdateCustomPage(options *CustomPageOptions, customPageID string, pageParameters CustomPageParameters) (CustomPage, error) {
+	return api.UpdateCustomPageContext(context.TODO(), options, customPageID, pageParameters)
+}
+
+// UpdateCustomPageContext is like UpdateCustomPage but takes a context.
+func (api *API) UpdateCustomPageContext(ctx context.Context, options *CustomPageOptions, customPageID string, pageParameters CustomPageParameters) (CustomPage, error) {
 	var (
 		pageType, identifier string
 	)
@@ -161,7 +177,7 @@ func (api *API) UpdateCustomPage(options *CustomPageOptions, customPageID string
 
 	uri := fmt.Sprintf("/%s/%s/custom_pages/%s", pageType, identifier, customPageID)
 
-	res, err := api.makeRequest("PUT", uri, pageParameters)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, pageParameters)
 	if err != nil {
 		return CustomPage{}, errors.Wrap(err, errMakeRequestError)
 	}
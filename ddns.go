@@ -0,0 +1,264 @@
+package cloudflare
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PublicIPSource finds the public address of the host, as seen from the
+// internet.
+type PublicIPSource interface {
+	// PublicIP returns the host's public IPv4 address, or its IPv6 address
+	// if ipv6 is true.
+	PublicIP(ctx context.Context, ipv6 bool) (net.IP, error)
+}
+
+// PublicIPSourceFunc is an adapter to allow the use of ordinary functions as
+// a PublicIPSource.
+type PublicIPSourceFunc func(ctx context.Context, ipv6 bool) (net.IP, error)
+
+// PublicIP calls f(ctx, ipv6).
+func (f PublicIPSourceFunc) PublicIP(ctx context.Context, ipv6 bool) (net.IP, error) {
+	return f(ctx, ipv6)
+}
+
+// HTTPPublicIPSource asks a web service which address the host's requests
+// come from. The response body is either the bare address or, like
+// Cloudflare's /cdn-cgi/trace, lines of key=value pairs including ip=.
+type HTTPPublicIPSource struct {
+	IPv4URL string
+	IPv6URL string
+	// Client is used to make the requests. If nil, http.DefaultClient is
+	// used.
+	Client *http.Client
+}
+
+// DefaultPublicIPSource asks Cloudflare's resolvers, reached by IPv4 and
+// IPv6 address so that each request is made over the right protocol.
+var DefaultPublicIPSource = HTTPPublicIPSource{
+	IPv4URL: "https://1.1.1.1/cdn-cgi/trace",
+	IPv6URL: "https://[2606:4700:4700::1111]/cdn-cgi/trace",
+}
+
+// PublicIP implements PublicIPSource.
+func (s HTTPPublicIPSource) PublicIP(ctx context.Context, ipv6 bool) (net.IP, error) {
+	url := s.IPv4URL
+	if ipv6 {
+		url = s.IPv6URL
+	}
+	if url == "" {
+		return nil, errors.New("no URL to find the public IP address")
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "HTTP request creation failed")
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "error finding public IP address")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("error finding public IP address: %s returned HTTP status %d", url, resp.StatusCode)
+	}
+
+	text := strings.TrimSpace(string(body))
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "ip=") {
+			text = strings.TrimPrefix(line, "ip=")
+			break
+		}
+	}
+	ip := net.ParseIP(text)
+	if ip == nil || (ip.To4() == nil) != ipv6 {
+		version := "4"
+		if ipv6 {
+			version = "6"
+		}
+		return nil, errors.Errorf("%s did not return an IPv%s address", url, version)
+	}
+	return ip, nil
+}
+
+// DDNSOptions configures UpdateDDNS and RunDDNS.
+type DDNSOptions struct {
+	// Source finds the host's public addresses. If nil,
+	// DefaultPublicIPSource is used.
+	Source PublicIPSource
+	// IPv4 and IPv6 select which of the A and AAAA records are kept up to
+	// date. If neither is set, only the A record is.
+	IPv4 bool
+	IPv6 bool
+	// Create creates a record if the name has none of the type, using TTL
+	// and Proxied. Without it, a missing record is an error. Existing
+	// records always keep their own TTL and proxy setting.
+	Create  bool
+	TTL     int
+	Proxied bool
+
+	// Interval is how often RunDDNS checks the address; the default is five
+	// minutes. Backoff decides how long it waits after a failed check, with
+	// attempt counting consecutive failures; the default doubles from ten
+	// seconds up to Interval.
+	Interval time.Duration
+	Backoff  Backoff
+	// Report, if set, is called by RunDDNS with the result of every check.
+	Report func([]DDNSUpdate, error)
+}
+
+// DDNSUpdate describes the record of one type kept up to date by UpdateDDNS.
+type DDNSUpdate struct {
+	Type string
+	IP   net.IP
+	// Record is the record as it is after the update.
+	Record DNSRecord
+	// Previous is the record's content before the update, or "" if the
+	// record was created.
+	Previous string
+	Changed  bool
+}
+
+// UpdateDDNS points the A and AAAA records of name, which must be fully
+// qualified, at the host's current public addresses. Records are only
+// changed if their address differs, and keep their TTL and proxy setting.
+// A name with several records of a type is left alone and reported as an
+// error, since there is no telling which of them to change.
+//
+// The updates made before any error are returned along with it.
+func (api *API) UpdateDDNS(zoneID, name string, opts DDNSOptions) ([]DDNSUpdate, error) {
+	return api.UpdateDDNSContext(context.TODO(), zoneID, name, opts)
+}
+
+// UpdateDDNSContext is like UpdateDDNS but takes a context.
+func (api *API) UpdateDDNSContext(ctx context.Context, zoneID, name string, opts DDNSOptions) ([]DDNSUpdate, error) {
+	source := opts.Source
+	if source == nil {
+		source = DefaultPublicIPSource
+	}
+	var types []string
+	if opts.IPv4 || !opts.IPv6 {
+		types = append(types, "A")
+	}
+	if opts.IPv6 {
+		types = append(types, "AAAA")
+	}
+
+	var updates []DDNSUpdate
+	for _, rtype := range types {
+		update, err := api.updateDDNSRecord(ctx, zoneID, name, rtype, source, opts)
+		if err != nil {
+			return updates, err
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+func (api *API) updateDDNSRecord(ctx context.Context, zoneID, name, rtype string, source PublicIPSource, opts DDNSOptions) (DDNSUpdate, error) {
+	ip, err := source.PublicIP(ctx, rtype == "AAAA")
+	if err != nil {
+		return DDNSUpdate{}, err
+	}
+	update := DDNSUpdate{Type: rtype, IP: ip}
+
+	records, err := api.ListDNSRecordsContext(ctx, zoneID, DNSListOptions{Name: name, Type: rtype})
+	if err != nil {
+		return DDNSUpdate{}, err
+	}
+	switch len(records) {
+	case 0:
+		if !opts.Create {
+			return DDNSUpdate{}, errors.Errorf("no %s record for %s", rtype, name)
+		}
+		resp, err := api.CreateDNSRecordContext(ctx, zoneID, DNSRecord{
+			Type:    rtype,
+			Name:    name,
+			Content: ip.String(),
+			TTL:     opts.TTL,
+			Proxied: opts.Proxied,
+		})
+		if err != nil {
+			return DDNSUpdate{}, err
+		}
+		update.Record = resp.Result
+		update.Changed = true
+		return update, nil
+	case 1:
+	default:
+		return DDNSUpdate{}, errors.Errorf("%s has %d %s records; refusing to choose one to update", name, len(records), rtype)
+	}
+
+	rr := records[0]
+	update.Record = rr
+	update.Previous = rr.Content
+	if current := net.ParseIP(rr.Content); current != nil && current.Equal(ip) {
+		return update, nil
+	}
+	// Send the whole record back, as the update replaces the proxy setting
+	// and priority even when they are left unset.
+	rr.Content = ip.String()
+	if err := api.UpdateDNSRecordContext(ctx, zoneID, rr.ID, rr); err != nil {
+		return DDNSUpdate{}, err
+	}
+	update.Record = rr
+	update.Changed = true
+	return update, nil
+}
+
+// RunDDNS calls UpdateDDNS every opts.Interval until ctx is done, and then
+// returns ctx.Err(). A failed update is retried sooner, after the delay
+// chosen by opts.Backoff.
+func (api *API) RunDDNS(ctx context.Context, zoneID, name string, opts DDNSOptions) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	backoff := opts.Backoff
+	if backoff == nil {
+		backoff = ExponentialBackoff{Min: 10 * time.Second, Max: interval}
+	}
+
+	failures := 0
+	for {
+		updates, err := api.UpdateDDNSContext(ctx, zoneID, name, opts)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if opts.Report != nil {
+			opts.Report(updates, err)
+		}
+
+		delay := interval
+		if err != nil {
+			failures++
+			delay = backoff.Delay(failures, nil)
+		} else {
+			failures = 0
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package cloudflare_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixedIPSource returns the same addresses every time.
+func fixedIPSource(v4, v6 string) cloudflare.PublicIPSource {
+	return cloudflare.PublicIPSourceFunc(func(ctx context.Context, ipv6 bool) (net.IP, error) {
+		if ipv6 {
+			return net.ParseIP(v6), nil
+		}
+		return net.ParseIP(v4), nil
+	})
+}
+
+func TestUpdateDDNS(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "home.example.com", Content: "192.0.2.1", Proxied: true, TTL: 1})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "AAAA", Name: "home.example.com", Content: "2001:db8::1", TTL: 300})
+
+	opts := cloudflare.DDNSOptions{Source: fixedIPSource("192.0.2.1", "2001:0db8::1"), IPv4: true, IPv6: true}
+	updates, err := api.UpdateDDNS(zone.ID, "home.example.com", opts)
+	if assert.NoError(t, err) && assert.Len(t, updates, 2) {
+		assert.False(t, updates[0].Changed)
+		assert.False(t, updates[1].Changed)
+	}
+	for _, req := range srv.Requests() {
+		assert.Equal(t, "GET", req.Method, "unchanged addresses must not be updated")
+	}
+
+	opts.Source = fixedIPSource("198.51.100.7", "2001:db8::2")
+	updates, err = api.UpdateDDNS(zone.ID, "home.example.com", opts)
+	if assert.NoError(t, err) && assert.Len(t, updates, 2) {
+		assert.True(t, updates[0].Changed)
+		assert.Equal(t, "192.0.2.1", updates[0].Previous)
+		assert.Equal(t, "198.51.100.7", updates[0].Record.Content)
+		assert.Equal(t, "AAAA", updates[1].Type)
+		assert.Equal(t, "2001:db8::2", updates[1].Record.Content)
+	}
+	for _, rr := range srv.DNSRecords(zone.ID) {
+		switch rr.Type {
+		case "A":
+			assert.Equal(t, "198.51.100.7", rr.Content)
+			assert.True(t, rr.Proxied)
+			assert.Equal(t, 1, rr.TTL)
+		case "AAAA":
+			assert.Equal(t, "2001:db8::2", rr.Content)
+			assert.False(t, rr.Proxied)
+			assert.Equal(t, 300, rr.TTL)
+		}
+	}
+}
+
+func TestUpdateDDNS_MissingAndDuplicateRecords(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	opts := cloudflare.DDNSOptions{Source: fixedIPSource("192.0.2.1", "")}
+
+	_, err := api.UpdateDDNS(zone.ID, "home.example.com", opts)
+	assert.EqualError(t, err, "no A record for home.example.com")
+
+	opts.Create = true
+	opts.TTL = 120
+	updates, err := api.UpdateDDNS(zone.ID, "home.example.com", opts)
+	if assert.NoError(t, err) && assert.Len(t, updates, 1) {
+		assert.True(t, updates[0].Changed)
+		assert.Equal(t, "", updates[0].Previous)
+		assert.Equal(t, 120, updates[0].Record.TTL)
+	}
+
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "home.example.com", Content: "192.0.2.2", TTL: 1})
+	_, err = api.UpdateDDNS(zone.ID, "home.example.com", opts)
+	assert.EqualError(t, err, "home.example.com has 2 A records; refusing to choose one to update")
+}
+
+func TestRunDDNS(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "home.example.com", Content: "192.0.2.1", TTL: 1})
+
+	calls := 0
+	source := cloudflare.PublicIPSourceFunc(func(ctx context.Context, ipv6 bool) (net.IP, error) {
+		calls++
+		if calls <= 2 {
+			return nil, errors.New("no route to host")
+		}
+		return net.ParseIP("192.0.2.9"), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var attempts []int
+	var reports []string
+	err := api.RunDDNS(ctx, zone.ID, "home.example.com", cloudflare.DDNSOptions{
+		Source:   source,
+		Interval: time.Millisecond,
+		Backoff: cloudflare.BackoffFunc(func(attempt int, resp *http.Response) time.Duration {
+			attempts = append(attempts, attempt)
+			return time.Millisecond
+		}),
+		Report: func(updates []cloudflare.DDNSUpdate, err error) {
+			if err != nil {
+				reports = append(reports, err.Error())
+				return
+			}
+			reports = append(reports, fmt.Sprintf("%s changed=%t", updates[0].Record.Content, updates[0].Changed))
+			if len(reports) == 4 {
+				cancel()
+			}
+		},
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []int{1, 2}, attempts)
+	assert.Equal(t, []string{
+		"no route to host",
+		"no route to host",
+		"192.0.2.9 changed=true",
+		"192.0.2.9 changed=false",
+	}, reports)
+}
+
+func TestHTTPPublicIPSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cdn-cgi/trace":
+			fmt.Fprint(w, "fl=1f1\nh=1.1.1.1\nip=203.0.113.5\nts=1570000000.000\n")
+		case "/plain":
+			fmt.Fprint(w, "2001:db8::5\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	source := cloudflare.HTTPPublicIPSource{IPv4URL: ts.URL + "/cdn-cgi/trace", IPv6URL: ts.URL + "/plain"}
+	ip, err := source.PublicIP(context.Background(), false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "203.0.113.5", ip.String())
+	}
+	ip, err = source.PublicIP(context.Background(), true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2001:db8::5", ip.String())
+	}
+
+	source.IPv6URL = source.IPv4URL
+	_, err = source.PublicIP(context.Background(), true)
+	assert.EqualError(t, err, ts.URL+"/cdn-cgi/trace did not return an IPv6 address")
+
+	source.IPv4URL = ts.URL + "/missing"
+	_, err = source.PublicIP(context.Background(), false)
+	assert.Error(t, err)
+}
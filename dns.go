@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -11,21 +12,21 @@ import (
 
 // DNSRecord represents a DNS record in a zone.
 type DNSRecord struct {
-	ID         string      `json:"id,omitempty"`
-	Type       string      `json:"type,omitempty"`
-	Name       string      `json:"name,omitempty"`
-	Content    string      `json:"content,omitempty"`
-	Proxiable  bool        `json:"proxiable,omitempty"`
-	Proxied    bool        `json:"proxied"`
-	TTL        int         `json:"ttl,omitempty"`
-	Locked     bool        `json:"locked,omitempty"`
-	ZoneID     string      `json:"zone_id,omitempty"`
-	ZoneName   string      `json:"zone_name,omitempty"`
-	CreatedOn  time.Time   `json:"created_on,omitempty"`
-	ModifiedOn time.Time   `json:"modified_on,omitempty"`
-	Data       interface{} `json:"data,omitempty"` // data returned by: SRV, LOC
-	Meta       interface{} `json:"meta,omitempty"`
-	Priority   int         `json:"priority"`
+	ID         string         `json:"id,omitempty"`
+	Type       string         `json:"type,omitempty"`
+	Name       string         `json:"name,omitempty"`
+	Content    string         `json:"content,omitempty"`
+	Proxiable  bool           `json:"proxiable,omitempty"`
+	Proxied    bool           `json:"proxied"`
+	TTL        int            `json:"ttl,omitempty"`
+	Locked     bool           `json:"locked,omitempty"`
+	ZoneID     string         `json:"zone_id,omitempty"`
+	ZoneName   string         `json:"zone_name,omitempty"`
+	CreatedOn  time.Time      `json:"created_on,omitempty"`
+	ModifiedOn time.Time      `json:"modified_on,omitempty"`
+	Data       interface{}    `json:"data,omitempty"` // data returned by: SRV, LOC, CAA, etc; see DNSRecordData
+	Meta       *DNSRecordMeta `json:"meta,omitempty"`
+	Priority   int            `json:"priority"`
 }
 
 // DNSRecordResponse represents the response from the DNS endpoint.
@@ -46,8 +47,13 @@ type DNSListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-create-dns-record
 func (api *API) CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse, error) {
+	return api.CreateDNSRecordContext(context.TODO(), zoneID, rr)
+}
+
+// CreateDNSRecordContext is like CreateDNSRecord but takes a context.
+func (api *API) CreateDNSRecordContext(ctx context.Context, zoneID string, rr DNSRecord) (*DNSRecordResponse, error) {
 	uri := "/zones/" + zoneID + "/dns_records"
-	res, err := api.makeRequest("POST", uri, rr)
+	res, err := api.makeRequestContext(ctx, "POST", uri, rr)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -61,61 +67,196 @@ func (api *API) CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse
 	return recordResp, nil
 }
 
+// DNSListOptions filters, orders and pages the DNS records returned by
+// ListDNSRecords.
+type DNSListOptions struct {
+	// Name, Type and Content match records exactly.
+	Name    string
+	Type    string
+	Content string
+	// NameContains and NameStartsWith match records whose name contains or
+	// starts with the given text.
+	NameContains   string
+	NameStartsWith string
+	// Proxied, if set, matches records that are or are not proxied.
+	Proxied *bool
+	// Match is "all" (the default) to return records that match every
+	// filter, or "any" for records that match at least one.
+	Match string
+	// Order is the field to sort by: "type", "name", "content", "ttl" or
+	// "proxied". Direction is "asc" or "desc".
+	Order     string
+	Direction string
+	// PerPage is the number of records to fetch per request. It defaults to
+	// 50.
+	PerPage int
+}
+
+// validate checks the options that take one of a fixed set of values.
+func (o DNSListOptions) validate() error {
+	switch o.Match {
+	case "", "any", "all":
+	default:
+		return errors.Errorf("invalid DNS list match %q: must be any or all", o.Match)
+	}
+	switch o.Order {
+	case "", "type", "name", "content", "ttl", "proxied":
+	default:
+		return errors.Errorf("invalid DNS list order %q: must be type, name, content, ttl or proxied", o.Order)
+	}
+	switch o.Direction {
+	case "", "asc", "desc":
+	default:
+		return errors.Errorf("invalid DNS list direction %q: must be asc or desc", o.Direction)
+	}
+	return nil
+}
+
 // DNSRecords returns a slice of DNS records for the given zone identifier.
 //
-// This takes a DNSRecord to allow filtering of the results returned.
+// This takes a DNSRecord to allow filtering of the results returned. Use
+// ListDNSRecords for more ways to filter and order them.
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
 func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
+	return api.DNSRecordsContext(context.TODO(), zoneID, rr)
+}
+
+// DNSRecordsContext is like DNSRecords but takes a context.
+func (api *API) DNSRecordsContext(ctx context.Context, zoneID string, rr DNSRecord) ([]DNSRecord, error) {
+	return api.ListDNSRecordsContext(ctx, zoneID, DNSListOptions{Name: rr.Name, Type: rr.Type, Content: rr.Content})
+}
+
+// ListDNSRecords returns the DNS records of a zone that match opts, in the
+// order it asks for.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
+func (api *API) ListDNSRecords(zoneID string, opts DNSListOptions) ([]DNSRecord, error) {
+	return api.ListDNSRecordsContext(context.TODO(), zoneID, opts)
+}
+
+// ListDNSRecordsContext is like ListDNSRecords but takes a context.
+func (api *API) ListDNSRecordsContext(ctx context.Context, zoneID string, opts DNSListOptions) ([]DNSRecord, error) {
+	if err := opts.validate(); err != nil {
+		return []DNSRecord{}, err
+	}
+	records, err := api.ListDNSRecordsIter(ctx, zoneID, opts).All(api.paginationConcurrency)
+	if err != nil {
+		return []DNSRecord{}, err
+	}
+	return records, nil
+}
+
+// dnsRecordsPage fetches a single page of the DNS records for a zone.
+func (api *API) dnsRecordsPage(ctx context.Context, zoneID string, opts DNSListOptions, pageOpts PaginationOptions) ([]DNSRecord, ResultInfo, error) {
 	// Construct a query string
 	v := url.Values{}
-	// Request as many records as possible per page - API max is 50
-	v.Set("per_page", "50")
-	if rr.Name != "" {
-		v.Set("name", rr.Name)
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+	if opts.Name != "" {
+		v.Set("name", opts.Name)
+	}
+	if opts.Type != "" {
+		v.Set("type", opts.Type)
+	}
+	if opts.Content != "" {
+		v.Set("content", opts.Content)
+	}
+	if opts.NameContains != "" {
+		v.Set("name.contains", opts.NameContains)
 	}
-	if rr.Type != "" {
-		v.Set("type", rr.Type)
+	if opts.NameStartsWith != "" {
+		v.Set("name.startswith", opts.NameStartsWith)
 	}
-	if rr.Content != "" {
-		v.Set("content", rr.Content)
+	if opts.Proxied != nil {
+		v.Set("proxied", strconv.FormatBool(*opts.Proxied))
+	}
+	if opts.Match != "" {
+		v.Set("match", opts.Match)
+	}
+	if opts.Order != "" {
+		v.Set("order", opts.Order)
+	}
+	if opts.Direction != "" {
+		v.Set("direction", opts.Direction)
 	}
 
-	var query string
+	uri := "/zones/" + zoneID + "/dns_records" + "?" + v.Encode()
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return []DNSRecord{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r DNSListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DNSRecord{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// DNSRecordIterator iterates over DNS records. See Paginator.
+type DNSRecordIterator struct {
+	*Paginator
+}
+
+// Value returns the current DNS record.
+func (it *DNSRecordIterator) Value() DNSRecord {
+	v, _ := it.value().(DNSRecord)
+	return v
+}
+
+// All returns every remaining DNS record, fetching up to concurrency pages at a
+// time.
+func (it *DNSRecordIterator) All(concurrency int) ([]DNSRecord, error) {
 	var records []DNSRecord
-	page := 1
-
-	// Loop over makeRequest until what we've fetched all records
-	for {
-		v.Set("page", strconv.Itoa(page))
-		query = "?" + v.Encode()
-		uri := "/zones/" + zoneID + "/dns_records" + query
-		res, err := api.makeRequest("GET", uri, nil)
-		if err != nil {
-			return []DNSRecord{}, errors.Wrap(err, errMakeRequestError)
-		}
-		var r DNSListResponse
-		err = json.Unmarshal(res, &r)
-		if err != nil {
-			return []DNSRecord{}, errors.Wrap(err, errUnmarshalError)
-		}
-		records = append(records, r.Result...)
-		if r.ResultInfo.Page >= r.ResultInfo.TotalPages {
-			break
-		}
-		// Loop around and fetch the next page
-		page++
+	if err := it.collect(concurrency, &records); err != nil {
+		return nil, err
 	}
 	return records, nil
 }
 
+// DNSRecordsIter returns an iterator over the DNS records of a zone, filtered
+// by rr in the same way as DNSRecords.
+func (api *API) DNSRecordsIter(ctx context.Context, zoneID string, rr DNSRecord, pageOpts PaginationOptions) *DNSRecordIterator {
+	opts := DNSListOptions{Name: rr.Name, Type: rr.Type, Content: rr.Content}
+	return &DNSRecordIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.dnsRecordsPage(ctx, zoneID, opts, pageOpts)
+	})}
+}
+
+// ListDNSRecordsIter returns an iterator over the DNS records of a zone that
+// match opts, in the same way as ListDNSRecords. Invalid options are
+// reported by the first call to Next.
+func (api *API) ListDNSRecordsIter(ctx context.Context, zoneID string, opts DNSListOptions) *DNSRecordIterator {
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		// Request as many records as possible per page - API max is 50
+		perPage = 50
+	}
+	return &DNSRecordIterator{newPaginator(ctx, PaginationOptions{PerPage: perPage}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		if err := opts.validate(); err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return api.dnsRecordsPage(ctx, zoneID, opts, pageOpts)
+	})}
+}
+
 // DNSRecord returns a single DNS record for the given zone & record
 // identifiers.
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-dns-record-details
 func (api *API) DNSRecord(zoneID, recordID string) (DNSRecord, error) {
+	return api.DNSRecordContext(context.TODO(), zoneID, recordID)
+}
+
+// DNSRecordContext is like DNSRecord but takes a context.
+func (api *API) DNSRecordContext(ctx context.Context, zoneID, recordID string) (DNSRecord, error) {
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return DNSRecord{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -132,7 +273,12 @@ func (api *API) DNSRecord(zoneID, recordID string) (DNSRecord, error) {
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-update-dns-record
 func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
-	rec, err := api.DNSRecord(zoneID, recordID)
+	return api.UpdateDNSRecordContext(context.TODO(), zoneID, recordID, rr)
+}
+
+// UpdateDNSRecordContext is like UpdateDNSRecord but takes a context.
+func (api *API) UpdateDNSRecordContext(ctx context.Context, zoneID, recordID string, rr DNSRecord) error {
+	rec, err := api.DNSRecordContext(ctx, zoneID, recordID)
 	if err != nil {
 		return err
 	}
@@ -143,7 +289,7 @@ func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
 	}
 	rr.Type = rec.Type
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("PATCH", uri, rr)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, rr)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -160,8 +306,13 @@ func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-delete-dns-record
 func (api *API) DeleteDNSRecord(zoneID, recordID string) error {
+	return api.DeleteDNSRecordContext(context.TODO(), zoneID, recordID)
+}
+
+// DeleteDNSRecordContext is like DeleteDNSRecord but takes a context.
+func (api *API) DeleteDNSRecordContext(ctx context.Context, zoneID, recordID string) error {
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -0,0 +1,215 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DNSBatch is a set of DNS record changes to make in a zone. Updates and
+// Deletes need only the record ID to be set, although the other fields of a
+// deleted record are used to describe it in the report.
+type DNSBatch struct {
+	Creates []DNSRecord
+	Updates []DNSRecord
+	Deletes []DNSRecord
+}
+
+// DNSBatchOptions configures BatchDNS.
+type DNSBatchOptions struct {
+	// Concurrency is the most requests to have in flight at once, or
+	// DefaultBatchConcurrency if zero.
+	Concurrency int
+	// StopOnError skips the changes that have not been started once one
+	// has failed. Changes already in flight are allowed to finish.
+	StopOnError bool
+}
+
+// DNSBatchStatus is the outcome of one change in a DNSBatch.
+type DNSBatchStatus string
+
+// The outcomes of the changes in a DNSBatch.
+const (
+	DNSBatchSucceeded DNSBatchStatus = "succeeded"
+	DNSBatchFailed    DNSBatchStatus = "failed"
+	DNSBatchSkipped   DNSBatchStatus = "skipped"
+)
+
+// DNSBatchResult is the outcome of one change in a DNSBatch.
+type DNSBatchResult struct {
+	Action DNSRecordChangeAction
+	// Record is the record as given in the batch or, for a successful
+	// create, as created.
+	Record DNSRecord
+	Status DNSBatchStatus
+	// Err is why the change failed or was skipped. Errors holds the codes
+	// and messages the API gave for a failure, if it responded.
+	Err    error
+	Errors []ResponseInfo
+}
+
+// DNSBatchReport holds the outcome of every change in a DNSBatch, in the
+// order the batch lists them: creates, then updates, then deletes.
+type DNSBatchReport struct {
+	Results []DNSBatchResult
+}
+
+// Succeeded returns the results of the changes that were made.
+func (r DNSBatchReport) Succeeded() []DNSBatchResult {
+	return r.withStatus(DNSBatchSucceeded)
+}
+
+// Failed returns the results of the changes that were attempted and failed.
+func (r DNSBatchReport) Failed() []DNSBatchResult {
+	return r.withStatus(DNSBatchFailed)
+}
+
+// Skipped returns the results of the changes that were not attempted.
+func (r DNSBatchReport) Skipped() []DNSBatchResult {
+	return r.withStatus(DNSBatchSkipped)
+}
+
+func (r DNSBatchReport) withStatus(status DNSBatchStatus) []DNSBatchResult {
+	var out []DNSBatchResult
+	for _, result := range r.Results {
+		if result.Status == status {
+			out = append(out, result)
+		}
+	}
+	return out
+}
+
+// String returns a line per change, giving its status, the record and any
+// error.
+func (r DNSBatchReport) String() string {
+	var b strings.Builder
+	for _, result := range r.Results {
+		desc := "record " + result.Record.ID
+		if result.Record.Type != "" {
+			desc = describeDNSRecord(result.Record)
+		}
+		fmt.Fprintf(&b, "%s %s %s", result.Status, result.Action, desc)
+		if result.Err != nil {
+			fmt.Fprintf(&b, ": %s", result.Err)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// BatchDNS makes a batch of DNS record changes in a zone, several at a time.
+// Deletes are made first, then updates, then creates, so that a batch can
+// replace records whose names would otherwise clash. A failed change does
+// not stop the others unless opts.StopOnError is set.
+//
+// The report always holds the outcome of every change. The error is non-nil
+// if any change failed or was skipped.
+func (api *API) BatchDNS(zoneID string, batch DNSBatch, opts DNSBatchOptions) (DNSBatchReport, error) {
+	return api.BatchDNSContext(context.TODO(), zoneID, batch, opts)
+}
+
+// BatchDNSContext is like BatchDNS but takes a context. Changes that have
+// not been started when ctx is done are skipped.
+func (api *API) BatchDNSContext(ctx context.Context, zoneID string, batch DNSBatch, opts DNSBatchOptions) (DNSBatchReport, error) {
+	// Results is allocated up front, so that the slices taken by add share
+	// its backing array.
+	report := DNSBatchReport{
+		Results: make([]DNSBatchResult, 0, len(batch.Creates)+len(batch.Updates)+len(batch.Deletes)),
+	}
+	add := func(action DNSRecordChangeAction, records []DNSRecord) []DNSBatchResult {
+		start := len(report.Results)
+		for _, rr := range records {
+			report.Results = append(report.Results, DNSBatchResult{Action: action, Record: rr})
+		}
+		return report.Results[start:]
+	}
+	creates := add(DNSRecordCreate, batch.Creates)
+	updates := add(DNSRecordUpdate, batch.Updates)
+	deletes := add(DNSRecordDelete, batch.Deletes)
+
+	b := &dnsBatchRun{api: api, zoneID: zoneID, concurrency: opts.Concurrency, stopOnError: opts.StopOnError}
+	b.run(ctx, deletes)
+	b.run(ctx, updates)
+	b.run(ctx, creates)
+
+	failed, skipped := len(report.Failed()), len(report.Skipped())
+	if failed+skipped > 0 {
+		return report, errors.Errorf("%d of %d DNS record changes failed and %d were skipped", failed, len(report.Results), skipped)
+	}
+	return report, nil
+}
+
+// dnsBatchRun makes the changes of one BatchDNS call.
+type dnsBatchRun struct {
+	api         *API
+	zoneID      string
+	concurrency int
+	stopOnError bool
+
+	mu      sync.Mutex
+	stopped bool
+}
+
+// run makes the changes in results, filling in their outcomes.
+func (b *dnsBatchRun) run(ctx context.Context, results []DNSBatchResult) {
+	forEachConcurrently(b.concurrency, len(results), func(i int) {
+		b.do(ctx, &results[i])
+	})
+}
+
+// do makes a single change, unless the batch has been stopped.
+func (b *dnsBatchRun) do(ctx context.Context, result *DNSBatchResult) {
+	b.mu.Lock()
+	stopped := b.stopped
+	b.mu.Unlock()
+	if stopped {
+		result.Status = DNSBatchSkipped
+		result.Err = errors.New("skipped after an earlier change failed")
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		result.Status = DNSBatchSkipped
+		result.Err = err
+		return
+	}
+
+	rr := result.Record
+	var err error
+	switch result.Action {
+	case DNSRecordCreate:
+		var resp *DNSRecordResponse
+		if resp, err = b.api.CreateDNSRecordContext(ctx, b.zoneID, rr); err == nil {
+			result.Record = resp.Result
+		}
+	case DNSRecordUpdate:
+		if rr.ID == "" {
+			err = errors.New("record ID is required")
+			break
+		}
+		err = b.api.UpdateDNSRecordContext(ctx, b.zoneID, rr.ID, rr)
+	case DNSRecordDelete:
+		if rr.ID == "" {
+			err = errors.New("record ID is required")
+			break
+		}
+		err = b.api.DeleteDNSRecordContext(ctx, b.zoneID, rr.ID)
+	}
+
+	if err == nil {
+		result.Status = DNSBatchSucceeded
+		return
+	}
+	result.Status = DNSBatchFailed
+	result.Err = err
+	if apiErr, ok := apiErrorFrom(err); ok {
+		result.Errors = apiErr.Errors
+	}
+	if b.stopOnError {
+		b.mu.Lock()
+		b.stopped = true
+		b.mu.Unlock()
+	}
+}
@@ -0,0 +1,123 @@
+package cloudflare_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchDNS(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	old := srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "old.example.com", Content: "192.0.2.1", TTL: 1})
+	www := srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.2", TTL: 1})
+
+	batch := cloudflare.DNSBatch{
+		Creates: []cloudflare.DNSRecord{
+			{Type: "A", Name: "a.example.com", Content: "192.0.2.10"},
+			{Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+			{Type: "A", Name: "b.example.com", Content: "192.0.2.11"},
+		},
+		Updates: []cloudflare.DNSRecord{
+			{ID: www.ID, Content: "192.0.2.3", TTL: 300},
+			{Content: "192.0.2.4"},
+		},
+		Deletes: []cloudflare.DNSRecord{
+			{ID: old.ID},
+			{ID: "0123456789abcdef0123456789abcdef"},
+		},
+	}
+	report, err := api.BatchDNS(zone.ID, batch, cloudflare.DNSBatchOptions{Concurrency: 3})
+	assert.EqualError(t, err, "2 of 7 DNS record changes failed and 0 were skipped")
+	if !assert.Len(t, report.Results, 7) {
+		return
+	}
+
+	// The create of www.example.com runs after the update has changed its
+	// content, so it does not clash.
+	assert.Len(t, report.Succeeded(), 5)
+	for i, want := range []cloudflare.DNSBatchStatus{"succeeded", "succeeded", "succeeded", "succeeded", "failed", "succeeded", "failed"} {
+		assert.Equal(t, want, report.Results[i].Status, "result %d", i)
+	}
+	assert.Len(t, report.Results[0].Record.ID, 32)
+	assert.EqualError(t, report.Results[4].Err, "record ID is required")
+
+	missing := report.Results[6]
+	assert.True(t, cloudflare.IsNotFound(missing.Err))
+	if assert.Len(t, missing.Errors, 1) {
+		assert.Equal(t, 81044, missing.Errors[0].Code)
+	}
+
+	// A second run finds the records created by the first.
+	report, err = api.BatchDNS(zone.ID, cloudflare.DNSBatch{Creates: batch.Creates[:1]}, cloudflare.DNSBatchOptions{})
+	assert.Error(t, err)
+	if assert.Len(t, report.Failed(), 1) {
+		assert.True(t, cloudflare.IsConflict(report.Failed()[0].Err))
+		assert.Equal(t, 81057, report.Failed()[0].Errors[0].Code)
+	}
+	assert.Equal(t, "failed create A a.example.com \"192.0.2.10\" ttl=0: "+report.Failed()[0].Err.Error()+"\n", report.String())
+}
+
+func TestBatchDNS_StopOnError(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	batch := cloudflare.DNSBatch{
+		Deletes: []cloudflare.DNSRecord{{ID: "0123456789abcdef0123456789abcdef"}},
+		Creates: []cloudflare.DNSRecord{{Type: "A", Name: "a.example.com", Content: "192.0.2.10"}},
+	}
+	report, err := api.BatchDNS(zone.ID, batch, cloudflare.DNSBatchOptions{Concurrency: 1, StopOnError: true})
+	assert.EqualError(t, err, "1 of 2 DNS record changes failed and 1 were skipped")
+	if assert.Len(t, report.Skipped(), 1) {
+		assert.Equal(t, cloudflare.DNSRecordCreate, report.Skipped()[0].Action)
+	}
+	assert.Empty(t, srv.DNSRecords(zone.ID))
+}
+
+func TestBatchDNS_Concurrency(t *testing.T) {
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59"}}`)
+	}))
+	defer ts.Close()
+
+	api, err := cloudflare.New("deadbeef", "cloudflare@example.org", cloudflare.UsingRateLimit(100000))
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = ts.URL
+
+	var batch cloudflare.DNSBatch
+	for i := 0; i < 20; i++ {
+		batch.Creates = append(batch.Creates, cloudflare.DNSRecord{Type: "A", Name: fmt.Sprintf("host%d.example.com", i), Content: "192.0.2.1"})
+	}
+	report, err := api.BatchDNSContext(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", batch, cloudflare.DNSBatchOptions{Concurrency: 5})
+	assert.NoError(t, err)
+	assert.Len(t, report.Succeeded(), 20)
+	assert.True(t, maxSeen > 1 && maxSeen <= 5, "saw %d requests in flight", maxSeen)
+}
@@ -0,0 +1,355 @@
+package cloudflare
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DNSRecordMeta is the extra information the API returns about a DNS record.
+type DNSRecordMeta struct {
+	// AutoAdded is true for records Cloudflare added itself, such as when
+	// the zone was first scanned.
+	AutoAdded bool   `json:"auto_added"`
+	Source    string `json:"source,omitempty"`
+}
+
+// DNSRecordData is the structured data of a DNS record type whose content is
+// made up of several fields. Set it on a record with DNSRecord.SetData, and
+// read it back with DNSRecord.DecodeData.
+type DNSRecordData interface {
+	// RecordType returns the type of record the data belongs to, such as
+	// "SRV".
+	RecordType() string
+	// Validate checks the data the way the API does, so mistakes are
+	// caught before a request is made.
+	Validate() error
+}
+
+// SetData validates data and sets it as the record's data, setting the
+// record's type to match.
+func (r *DNSRecord) SetData(data DNSRecordData) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	r.Type = data.RecordType()
+	r.Data = data
+	return nil
+}
+
+// DecodeData decodes the record's data, as returned by the API, into data,
+// which should be a pointer to the data struct for the record's type, such
+// as *SRVRecordData.
+func (r DNSRecord) DecodeData(data DNSRecordData) error {
+	if !strings.EqualFold(r.Type, data.RecordType()) {
+		return errors.Errorf("cannot decode the data of a %s record as %s data", r.Type, data.RecordType())
+	}
+	if r.Data == nil {
+		return errors.Errorf("%s record has no data", r.Type)
+	}
+	b, err := json.Marshal(r.Data)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if err := json.Unmarshal(b, data); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
+
+// SRVRecordData is the data of an SRV record. The record's name is made up
+// of the service, protocol and name.
+type SRVRecordData struct {
+	Service  string `json:"service"`
+	Proto    string `json:"proto"`
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+	Port     int    `json:"port"`
+	Target   string `json:"target"`
+}
+
+// RecordType returns "SRV".
+func (SRVRecordData) RecordType() string { return "SRV" }
+
+// Validate checks that the service and protocol are underscored labels,
+// that the priority, weight and port are 16-bit values and that there is a
+// target.
+func (d SRVRecordData) Validate() error {
+	switch {
+	case !strings.HasPrefix(d.Service, "_") || len(d.Service) < 2:
+		return invalidDNSRecordData(d, "service %q must start with an underscore", d.Service)
+	case !strings.HasPrefix(d.Proto, "_") || len(d.Proto) < 2:
+		return invalidDNSRecordData(d, "protocol %q must start with an underscore", d.Proto)
+	case d.Name == "":
+		return invalidDNSRecordData(d, "name is required")
+	case d.Target == "":
+		return invalidDNSRecordData(d, "target is required")
+	}
+	return checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"priority", d.Priority, 0, 65535},
+		{"weight", d.Weight, 0, 65535},
+		{"port", d.Port, 0, 65535},
+	})
+}
+
+// LOCRecordData is the data of a LOC record (RFC 1876). Altitude is in
+// metres, and size and precisions are in metres too.
+type LOCRecordData struct {
+	LatDegrees    int     `json:"lat_degrees"`
+	LatMinutes    int     `json:"lat_minutes"`
+	LatSeconds    float64 `json:"lat_seconds"`
+	LatDirection  string  `json:"lat_direction"`
+	LongDegrees   int     `json:"long_degrees"`
+	LongMinutes   int     `json:"long_minutes"`
+	LongSeconds   float64 `json:"long_seconds"`
+	LongDirection string  `json:"long_direction"`
+	Altitude      float64 `json:"altitude"`
+	Size          float64 `json:"size"`
+	PrecisionHorz float64 `json:"precision_horz"`
+	PrecisionVert float64 `json:"precision_vert"`
+}
+
+// RecordType returns "LOC".
+func (LOCRecordData) RecordType() string { return "LOC" }
+
+// Validate checks that the coordinates, altitude, size and precisions are
+// within the ranges RFC 1876 allows.
+func (d LOCRecordData) Validate() error {
+	if d.LatDirection != "N" && d.LatDirection != "S" {
+		return invalidDNSRecordData(d, "latitude direction %q must be N or S", d.LatDirection)
+	}
+	if d.LongDirection != "E" && d.LongDirection != "W" {
+		return invalidDNSRecordData(d, "longitude direction %q must be E or W", d.LongDirection)
+	}
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"latitude degrees", d.LatDegrees, 0, 90},
+		{"latitude minutes", d.LatMinutes, 0, 59},
+		{"longitude degrees", d.LongDegrees, 0, 180},
+		{"longitude minutes", d.LongMinutes, 0, 59},
+	}); err != nil {
+		return err
+	}
+	switch {
+	case d.LatSeconds < 0 || d.LatSeconds >= 60:
+		return invalidDNSRecordData(d, "latitude seconds %v out of range", d.LatSeconds)
+	case d.LongSeconds < 0 || d.LongSeconds >= 60:
+		return invalidDNSRecordData(d, "longitude seconds %v out of range", d.LongSeconds)
+	case d.Altitude < -100000 || d.Altitude > 42849672.95:
+		return invalidDNSRecordData(d, "altitude %v out of range", d.Altitude)
+	case d.Size < 0 || d.Size > 90000000:
+		return invalidDNSRecordData(d, "size %v out of range", d.Size)
+	case d.PrecisionHorz < 0 || d.PrecisionHorz > 90000000:
+		return invalidDNSRecordData(d, "horizontal precision %v out of range", d.PrecisionHorz)
+	case d.PrecisionVert < 0 || d.PrecisionVert > 90000000:
+		return invalidDNSRecordData(d, "vertical precision %v out of range", d.PrecisionVert)
+	}
+	return nil
+}
+
+// CAARecordData is the data of a CAA record (RFC 8659).
+type CAARecordData struct {
+	Flags int `json:"flags"`
+	// Tag is "issue", "issuewild" or "iodef".
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// RecordType returns "CAA".
+func (CAARecordData) RecordType() string { return "CAA" }
+
+// Validate checks that the flags fit in a byte, that the tag is one the API
+// supports and that iodef values are mailto: or HTTP URLs.
+func (d CAARecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{{"flags", d.Flags, 0, 255}}); err != nil {
+		return err
+	}
+	switch d.Tag {
+	case "issue", "issuewild":
+		if d.Value == "" {
+			return invalidDNSRecordData(d, "value is required; use \";\" to allow no issuer")
+		}
+	case "iodef":
+		u, err := url.Parse(d.Value)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") {
+			return invalidDNSRecordData(d, "iodef value %q must be a mailto:, http: or https: URL", d.Value)
+		}
+	default:
+		return invalidDNSRecordData(d, "tag %q must be issue, issuewild or iodef", d.Tag)
+	}
+	return nil
+}
+
+// CERTRecordData is the data of a CERT record (RFC 4398). Certificate is
+// base64 encoded.
+type CERTRecordData struct {
+	Type        int    `json:"type"`
+	KeyTag      int    `json:"key_tag"`
+	Algorithm   int    `json:"algorithm"`
+	Certificate string `json:"certificate"`
+}
+
+// RecordType returns "CERT".
+func (CERTRecordData) RecordType() string { return "CERT" }
+
+// Validate checks the ranges of the numeric fields and that the certificate
+// is base64 encoded.
+func (d CERTRecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"type", d.Type, 0, 65535},
+		{"key tag", d.KeyTag, 0, 65535},
+		{"algorithm", d.Algorithm, 0, 255},
+	}); err != nil {
+		return err
+	}
+	return checkDNSRecordDataBase64(d, "certificate", d.Certificate)
+}
+
+// SSHFPRecordData is the data of an SSHFP record (RFC 4255). Fingerprint is
+// hex encoded.
+type SSHFPRecordData struct {
+	// Algorithm is 1 for RSA, 2 for DSA, 3 for ECDSA and 4 for Ed25519.
+	Algorithm int `json:"algorithm"`
+	// Type is 1 for SHA-1 fingerprints and 2 for SHA-256.
+	Type        int    `json:"type"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// RecordType returns "SSHFP".
+func (SSHFPRecordData) RecordType() string { return "SSHFP" }
+
+// Validate checks the ranges of the algorithm and type, and that the
+// fingerprint is hex of the right length for its type.
+func (d SSHFPRecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"algorithm", d.Algorithm, 0, 255},
+		{"type", d.Type, 0, 255},
+	}); err != nil {
+		return err
+	}
+	return checkDNSRecordDataHex(d, "fingerprint", d.Fingerprint, map[int]int{1: 20, 2: 32}[d.Type])
+}
+
+// TLSARecordData is the data of a TLSA record (RFC 6698). Certificate is the
+// hex encoded certificate association data.
+type TLSARecordData struct {
+	Usage        int    `json:"usage"`
+	Selector     int    `json:"selector"`
+	MatchingType int    `json:"matching_type"`
+	Certificate  string `json:"certificate"`
+}
+
+// RecordType returns "TLSA".
+func (TLSARecordData) RecordType() string { return "TLSA" }
+
+// Validate checks the ranges of the usage, selector and matching type, and
+// that the certificate data is hex of the right length for the matching
+// type.
+func (d TLSARecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"usage", d.Usage, 0, 255},
+		{"selector", d.Selector, 0, 255},
+		{"matching type", d.MatchingType, 0, 255},
+	}); err != nil {
+		return err
+	}
+	return checkDNSRecordDataHex(d, "certificate", d.Certificate, map[int]int{1: 32, 2: 64}[d.MatchingType])
+}
+
+// URIRecordData is the data of a URI record (RFC 7553). The record's
+// priority is set on the DNSRecord itself.
+type URIRecordData struct {
+	Weight int    `json:"weight"`
+	Target string `json:"content"`
+}
+
+// RecordType returns "URI".
+func (URIRecordData) RecordType() string { return "URI" }
+
+// Validate checks the range of the weight and that the target is an
+// absolute URI.
+func (d URIRecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{{"weight", d.Weight, 0, 65535}}); err != nil {
+		return err
+	}
+	if u, err := url.Parse(d.Target); err != nil || !u.IsAbs() {
+		return invalidDNSRecordData(d, "target %q must be an absolute URI", d.Target)
+	}
+	return nil
+}
+
+// DNSKEYRecordData is the data of a DNSKEY record (RFC 4034). PublicKey is
+// base64 encoded.
+type DNSKEYRecordData struct {
+	Flags     int    `json:"flags"`
+	Protocol  int    `json:"protocol"`
+	Algorithm int    `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+}
+
+// RecordType returns "DNSKEY".
+func (DNSKEYRecordData) RecordType() string { return "DNSKEY" }
+
+// Validate checks the ranges of the flags and algorithm, that the protocol
+// is 3 and that the public key is base64 encoded.
+func (d DNSKEYRecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"flags", d.Flags, 0, 65535},
+		{"algorithm", d.Algorithm, 0, 255},
+	}); err != nil {
+		return err
+	}
+	if d.Protocol != 3 {
+		return invalidDNSRecordData(d, "protocol %d must be 3", d.Protocol)
+	}
+	return checkDNSRecordDataBase64(d, "public key", d.PublicKey)
+}
+
+// dnsRecordDataRange is the allowed range of a numeric field.
+type dnsRecordDataRange struct {
+	field    string
+	value    int
+	min, max int
+}
+
+func checkDNSRecordDataRanges(d DNSRecordData, ranges []dnsRecordDataRange) error {
+	for _, r := range ranges {
+		if r.value < r.min || r.value > r.max {
+			return invalidDNSRecordData(d, "%s %d out of range %d-%d", r.field, r.value, r.min, r.max)
+		}
+	}
+	return nil
+}
+
+// checkDNSRecordDataHex checks that value is hex encoded, and if size is not
+// zero, that it encodes size bytes.
+func checkDNSRecordDataHex(d DNSRecordData, field, value string, size int) error {
+	b, err := hex.DecodeString(value)
+	switch {
+	case value == "":
+		return invalidDNSRecordData(d, "%s is required", field)
+	case err != nil:
+		return invalidDNSRecordData(d, "%s is not valid hex", field)
+	case size > 0 && len(b) != size:
+		return invalidDNSRecordData(d, "%s is %d bytes, want %d", field, len(b), size)
+	}
+	return nil
+}
+
+func checkDNSRecordDataBase64(d DNSRecordData, field, value string) error {
+	if value == "" {
+		return invalidDNSRecordData(d, "%s is required", field)
+	}
+	if _, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), "")); err != nil {
+		return invalidDNSRecordData(d, "%s is not valid base64", field)
+	}
+	return nil
+}
+
+func invalidDNSRecordData(d DNSRecordData, format string, args ...interface{}) error {
+	return errors.Errorf("invalid %s record data: "+format, append([]interface{}{d.RecordType()}, args...)...)
+}
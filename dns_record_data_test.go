@@ -0,0 +1,185 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var validDNSRecordData = []DNSRecordData{
+	SRVRecordData{Service: "_sip", Proto: "_tcp", Name: "example.com", Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"},
+	LOCRecordData{
+		LatDegrees: 37, LatMinutes: 46, LatSeconds: 46.5, LatDirection: "N",
+		LongDegrees: 122, LongMinutes: 23, LongSeconds: 35, LongDirection: "W",
+		Altitude: 0, Size: 100, PrecisionHorz: 0, PrecisionVert: 0,
+	},
+	CAARecordData{Flags: 0, Tag: "issue", Value: "letsencrypt.org"},
+	CAARecordData{Flags: 128, Tag: "iodef", Value: "mailto:security@example.com"},
+	CERTRecordData{Type: 1, KeyTag: 12345, Algorithm: 8, Certificate: "MIIBCgKCAQEA"},
+	SSHFPRecordData{Algorithm: 4, Type: 2, Fingerprint: "123456789abcdef67890123456789abcdef67890123456789abcdef123456789"},
+	TLSARecordData{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"},
+	URIRecordData{Weight: 1, Target: "ftp://ftp1.example.com/public"},
+	DNSKEYRecordData{Flags: 257, Protocol: 3, Algorithm: 13, PublicKey: "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ=="},
+}
+
+func TestDNSRecordData_Validate(t *testing.T) {
+	for _, d := range validDNSRecordData {
+		assert.NoError(t, d.Validate(), "%#v", d)
+	}
+
+	invalid := []DNSRecordData{
+		SRVRecordData{Service: "sip", Proto: "_tcp", Name: "example.com", Target: "sip.example.com"},
+		SRVRecordData{Service: "_sip", Proto: "tcp", Name: "example.com", Target: "sip.example.com"},
+		SRVRecordData{Service: "_sip", Proto: "_tcp", Name: "example.com", Port: 65536, Target: "sip.example.com"},
+		SRVRecordData{Service: "_sip", Proto: "_tcp", Name: "example.com", Weight: -1, Target: "sip.example.com"},
+		SRVRecordData{Service: "_sip", Proto: "_tcp", Name: "example.com"},
+		LOCRecordData{LatDegrees: 91, LatDirection: "N", LongDirection: "W"},
+		LOCRecordData{LatDirection: "E", LongDirection: "W"},
+		LOCRecordData{LatDirection: "N", LongDirection: "W", LongSeconds: 60},
+		CAARecordData{Tag: "issuer", Value: "letsencrypt.org"},
+		CAARecordData{Flags: 256, Tag: "issue", Value: "letsencrypt.org"},
+		CAARecordData{Tag: "issue"},
+		CAARecordData{Tag: "iodef", Value: "security@example.com"},
+		CERTRecordData{Type: 1, Algorithm: 256, Certificate: "MIIBCgKCAQEA"},
+		CERTRecordData{Type: 1, Certificate: "not base64!"},
+		SSHFPRecordData{Algorithm: 1, Type: 1, Fingerprint: "xyz"},
+		SSHFPRecordData{Algorithm: 1, Type: 2, Fingerprint: "123456789abcdef67890123456789abcdef67890"},
+		TLSARecordData{Usage: 3, Selector: 1, MatchingType: 2, Certificate: "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"},
+		URIRecordData{Weight: 1, Target: "not a uri"},
+		DNSKEYRecordData{Flags: 257, Protocol: 4, Algorithm: 13, PublicKey: "AwEAAQ=="},
+	}
+	for _, d := range invalid {
+		err := d.Validate()
+		if assert.Error(t, err, "%#v", d) {
+			assert.Contains(t, err.Error(), "invalid "+d.RecordType()+" record data")
+		}
+	}
+}
+
+func TestDNSRecordData_RoundTrip(t *testing.T) {
+	for _, d := range validDNSRecordData {
+		var rr DNSRecord
+		if !assert.NoError(t, rr.SetData(d)) {
+			continue
+		}
+		assert.Equal(t, d.RecordType(), rr.Type)
+
+		// Decode the record as it would come back from the API.
+		b, err := json.Marshal(rr)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		var decoded DNSRecord
+		if !assert.NoError(t, json.Unmarshal(b, &decoded)) {
+			continue
+		}
+		assert.IsType(t, map[string]interface{}{}, decoded.Data)
+
+		var got DNSRecordData
+		switch d.(type) {
+		case SRVRecordData:
+			got = &SRVRecordData{}
+		case LOCRecordData:
+			got = &LOCRecordData{}
+		case CAARecordData:
+			got = &CAARecordData{}
+		case CERTRecordData:
+			got = &CERTRecordData{}
+		case SSHFPRecordData:
+			got = &SSHFPRecordData{}
+		case TLSARecordData:
+			got = &TLSARecordData{}
+		case URIRecordData:
+			got = &URIRecordData{}
+		case DNSKEYRecordData:
+			got = &DNSKEYRecordData{}
+		}
+		if assert.NoError(t, decoded.DecodeData(got)) {
+			assert.Equal(t, d, dereferenceDNSRecordData(got))
+		}
+	}
+}
+
+// dereferenceDNSRecordData returns the value d points to.
+func dereferenceDNSRecordData(d DNSRecordData) DNSRecordData {
+	switch v := d.(type) {
+	case *SRVRecordData:
+		return *v
+	case *LOCRecordData:
+		return *v
+	case *CAARecordData:
+		return *v
+	case *CERTRecordData:
+		return *v
+	case *SSHFPRecordData:
+		return *v
+	case *TLSARecordData:
+		return *v
+	case *URIRecordData:
+		return *v
+	case *DNSKEYRecordData:
+		return *v
+	}
+	return d
+}
+
+func TestDNSRecord_SetDataInvalid(t *testing.T) {
+	rr := DNSRecord{Type: "A"}
+	assert.Error(t, rr.SetData(CAARecordData{Tag: "bogus", Value: "x"}))
+	assert.Equal(t, "A", rr.Type)
+	assert.Nil(t, rr.Data)
+}
+
+func TestDNSRecord_DecodeDataWrongType(t *testing.T) {
+	rr := DNSRecord{Type: "SRV", Data: map[string]interface{}{"port": 5060}}
+	assert.Error(t, rr.DecodeData(&CAARecordData{}))
+	assert.Error(t, DNSRecord{Type: "CAA"}.DecodeData(&CAARecordData{}))
+}
+
+func TestCreateDNSRecord_TypedData(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(b, &body))
+		assert.Equal(t, "CAA", body["type"])
+		assert.Equal(t, map[string]interface{}{"flags": 0.0, "tag": "issue", "value": "letsencrypt.org"}, body["data"])
+		assert.NotContains(t, body, "meta")
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"type": "CAA",
+				"name": "example.com",
+				"content": "0 issue letsencrypt.org",
+				"data": {"flags": 0, "tag": "issue", "value": "letsencrypt.org"},
+				"meta": {"auto_added": false, "source": "primary"}
+			}
+		}`)
+	})
+
+	rr := DNSRecord{Name: "example.com"}
+	assert.NoError(t, rr.SetData(CAARecordData{Tag: "issue", Value: "letsencrypt.org"}))
+
+	resp, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", rr)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, &DNSRecordMeta{AutoAdded: false, Source: "primary"}, resp.Result.Meta)
+
+	var caa CAARecordData
+	if assert.NoError(t, resp.Result.DecodeData(&caa)) {
+		assert.Equal(t, CAARecordData{Tag: "issue", Value: "letsencrypt.org"}, caa)
+	}
+}
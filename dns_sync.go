@@ -0,0 +1,451 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// dnsOwnershipLabel is the label prepended to a record's name to form the
+// name of its ownership marker.
+const dnsOwnershipLabel = "_managed-by"
+
+// DNSRecordChangeAction is the kind of change a DNSRecordChange makes.
+type DNSRecordChangeAction string
+
+// The changes a DNS sync plan can make.
+const (
+	DNSRecordCreate DNSRecordChangeAction = "create"
+	DNSRecordUpdate DNSRecordChangeAction = "update"
+	DNSRecordDelete DNSRecordChangeAction = "delete"
+)
+
+// DNSRecordChange is a single change in a DNSSyncPlan.
+type DNSRecordChange struct {
+	Action DNSRecordChangeAction
+	// Existing is the record as it is now, for updates and deletes.
+	Existing DNSRecord
+	// Desired is the record as it should be, for creates and updates.
+	Desired DNSRecord
+	// Reason says why a skipped change was not planned.
+	Reason string
+}
+
+// String describes the change on a single line: "+" for creates, "~" for
+// updates with the fields that change, and "-" for deletes.
+func (c DNSRecordChange) String() string {
+	var s string
+	switch c.Action {
+	case DNSRecordCreate:
+		s = "+ " + describeDNSRecord(c.Desired)
+	case DNSRecordUpdate:
+		s = fmt.Sprintf("~ %s (%s)", describeDNSRecord(c.Existing), strings.Join(dnsRecordDiff(c.Existing, c.Desired), ", "))
+	case DNSRecordDelete:
+		s = "- " + describeDNSRecord(c.Existing)
+	}
+	if c.Reason != "" {
+		s += ": " + c.Reason
+	}
+	return s
+}
+
+// DNSSyncOptions controls how PlanDNSSync reconciles a zone.
+type DNSSyncOptions struct {
+	// NoDeletes keeps existing records that are not desired, rather than
+	// deleting them. Records whose content changes are then created anew
+	// alongside the old ones.
+	NoDeletes bool
+
+	// ManagedBy, if set, limits the sync to the records it owns. Ownership of
+	// the records of a name and type is recorded in a TXT record named
+	// "_managed-by.<name>" with the content "managed-by=<ManagedBy>,type=<type>",
+	// which the plan creates along with the records and deletes when they are
+	// gone. Records without a marker are never changed or deleted, and
+	// records are not created alongside them.
+	ManagedBy string
+}
+
+// DNSSyncPlan is the set of changes that bring a zone's DNS records in line
+// with a desired set. Review it with String, then carry it out with
+// ApplyDNSSyncPlan.
+type DNSSyncPlan struct {
+	ZoneID  string
+	Changes []DNSRecordChange
+	// Skipped lists the changes that were needed but not planned because of
+	// the DNSSyncOptions, each with a Reason.
+	Skipped []DNSRecordChange
+}
+
+// Empty reports whether the plan makes no changes.
+func (p DNSSyncPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String describes the plan, one change per line, followed by the skipped
+// changes.
+func (p DNSSyncPlan) String() string {
+	var sb strings.Builder
+	for _, c := range p.Changes {
+		fmt.Fprintln(&sb, c)
+	}
+	for _, c := range p.Skipped {
+		fmt.Fprintln(&sb, "! "+c.String())
+	}
+	return sb.String()
+}
+
+// PlanDNSSync compares the desired records of a zone with its current
+// records and returns the changes needed to make them match. Nothing is
+// changed until the plan is applied.
+//
+// Records are identified by their name, type and content. Names may be given
+// relative to the zone, or as "@" for the zone apex. A desired record that
+// matches an existing one is updated if its TTL, proxied status or priority
+// differ. The TTL of a proxied record is ignored, since the API always sets
+// it to 1 (automatic), and a TTL of 0 means automatic. Where the content of
+// records of the same name and type changes, existing records are updated
+// with the new content rather than deleted and recreated.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
+func (api *API) PlanDNSSync(zoneID string, desired []DNSRecord, opts DNSSyncOptions) (DNSSyncPlan, error) {
+	return api.PlanDNSSyncContext(context.TODO(), zoneID, desired, opts)
+}
+
+// PlanDNSSyncContext is like PlanDNSSync but takes a context.
+func (api *API) PlanDNSSyncContext(ctx context.Context, zoneID string, desired []DNSRecord, opts DNSSyncOptions) (DNSSyncPlan, error) {
+	zone, err := api.ZoneDetailsContext(ctx, zoneID)
+	if err != nil {
+		return DNSSyncPlan{}, err
+	}
+	existing, err := api.DNSRecordsContext(ctx, zoneID, DNSRecord{})
+	if err != nil {
+		return DNSSyncPlan{}, err
+	}
+	return planDNSSync(zoneID, zone.Name, existing, desired, opts)
+}
+
+// ApplyDNSSyncPlan makes the changes in a plan: deletes first, so that
+// records of another type can take their place, then updates, then creates.
+// It stops at the first change that fails; plan again to pick up where it
+// left off.
+func (api *API) ApplyDNSSyncPlan(plan DNSSyncPlan) error {
+	return api.ApplyDNSSyncPlanContext(context.TODO(), plan)
+}
+
+// ApplyDNSSyncPlanContext is like ApplyDNSSyncPlan but takes a context.
+func (api *API) ApplyDNSSyncPlanContext(ctx context.Context, plan DNSSyncPlan) error {
+	for _, action := range []DNSRecordChangeAction{DNSRecordDelete, DNSRecordUpdate, DNSRecordCreate} {
+		for _, c := range plan.Changes {
+			if c.Action != action {
+				continue
+			}
+			var err error
+			switch c.Action {
+			case DNSRecordDelete:
+				err = api.DeleteDNSRecordContext(ctx, plan.ZoneID, c.Existing.ID)
+			case DNSRecordUpdate:
+				err = api.UpdateDNSRecordContext(ctx, plan.ZoneID, c.Existing.ID, c.Desired)
+			case DNSRecordCreate:
+				_, err = api.CreateDNSRecordContext(ctx, plan.ZoneID, c.Desired)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to %s", strings.TrimLeft(c.String(), "+~- "))
+			}
+		}
+	}
+	return nil
+}
+
+// dnsRecordSet is the name and type shared by a set of records.
+type dnsRecordSet struct {
+	name, rrType string
+}
+
+// planDNSSync works out the changes that turn existing into desired.
+func planDNSSync(zoneID, zoneName string, existing, desired []DNSRecord, opts DNSSyncOptions) (DNSSyncPlan, error) {
+	zoneName = canonicalZoneFileName(zoneName)
+	plan := DNSSyncPlan{ZoneID: zoneID}
+
+	// Separate the ownership markers from the records they mark.
+	owned := make(map[dnsRecordSet]DNSRecord)
+	var current []DNSRecord
+	for _, rr := range existing {
+		rr = normalizeDNSRecord(rr, zoneName)
+		if set, owner, ok := parseDNSOwnershipMarker(rr); ok {
+			if owner == opts.ManagedBy {
+				owned[set] = rr
+			}
+			continue
+		}
+		current = append(current, rr)
+	}
+
+	wanted := make(map[string]DNSRecord)
+	var order []string
+	for _, rr := range desired {
+		rr = normalizeDNSRecord(rr, zoneName)
+		key := dnsRecordKey(rr)
+		if _, ok := wanted[key]; ok {
+			return DNSSyncPlan{}, errors.Errorf("duplicate desired record %s", describeDNSRecord(rr))
+		}
+		wanted[key] = rr
+		order = append(order, key)
+	}
+
+	// Records can only be managed where there are no records without a
+	// marker.
+	unowned := make(map[dnsRecordSet]bool)
+	if opts.ManagedBy != "" {
+		for _, rr := range current {
+			set := dnsRecordSet{rr.Name, rr.Type}
+			if _, ok := owned[set]; !ok {
+				unowned[set] = true
+			}
+		}
+	}
+	notOwned := "not managed by " + opts.ManagedBy
+
+	// Match existing records to desired ones by identity, collecting what is
+	// left over on each side by name and type.
+	leftExisting := make(map[dnsRecordSet][]DNSRecord)
+	leftDesired := make(map[dnsRecordSet][]DNSRecord)
+	matched := make(map[string]bool)
+	for _, rr := range current {
+		set := dnsRecordSet{rr.Name, rr.Type}
+		key := dnsRecordKey(rr)
+		want, ok := wanted[key]
+		if !ok || matched[key] {
+			leftExisting[set] = append(leftExisting[set], rr)
+			continue
+		}
+		matched[key] = true
+		if len(dnsRecordDiff(rr, want)) == 0 {
+			continue
+		}
+		c := DNSRecordChange{Action: DNSRecordUpdate, Existing: rr, Desired: want}
+		if unowned[set] {
+			c.Reason = notOwned
+			plan.Skipped = append(plan.Skipped, c)
+			continue
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	for _, key := range order {
+		if !matched[key] {
+			rr := wanted[key]
+			set := dnsRecordSet{rr.Name, rr.Type}
+			leftDesired[set] = append(leftDesired[set], rr)
+		}
+	}
+
+	// Pair off the leftovers of each name and type as updates, creating or
+	// deleting whatever remains.
+	sets := make(map[dnsRecordSet]bool)
+	for set := range leftExisting {
+		sets[set] = true
+	}
+	for set := range leftDesired {
+		sets[set] = true
+	}
+	remaining := make(map[dnsRecordSet]int)
+	for _, rr := range current {
+		remaining[dnsRecordSet{rr.Name, rr.Type}]++
+	}
+	for _, set := range sortedDNSRecordSets(sets) {
+		olds, news := leftExisting[set], leftDesired[set]
+		if unowned[set] {
+			// Records that are not ours and not desired are none of our
+			// business, but desired records that cannot be created are.
+			for _, rr := range news {
+				plan.Skipped = append(plan.Skipped, DNSRecordChange{Action: DNSRecordCreate, Desired: rr, Reason: fmt.Sprintf("%s records at %s are %s", set.rrType, set.name, notOwned)})
+			}
+			continue
+		}
+
+		if !opts.NoDeletes {
+			for len(olds) > 0 && len(news) > 0 {
+				plan.Changes = append(plan.Changes, DNSRecordChange{Action: DNSRecordUpdate, Existing: olds[0], Desired: news[0]})
+				olds, news = olds[1:], news[1:]
+			}
+		}
+		for _, rr := range news {
+			plan.Changes = append(plan.Changes, DNSRecordChange{Action: DNSRecordCreate, Desired: rr})
+			remaining[set]++
+		}
+		for _, rr := range olds {
+			c := DNSRecordChange{Action: DNSRecordDelete, Existing: rr}
+			if opts.NoDeletes {
+				c.Reason = "deletes disabled"
+				plan.Skipped = append(plan.Skipped, c)
+				continue
+			}
+			plan.Changes = append(plan.Changes, c)
+			remaining[set]--
+		}
+	}
+
+	// Claim the record sets the plan creates, and release those it empties.
+	if opts.ManagedBy != "" {
+		for set := range owned {
+			if _, ok := remaining[set]; !ok {
+				remaining[set] = 0
+			}
+		}
+		for set, n := range remaining {
+			marker, ok := owned[set]
+			switch {
+			case n > 0 && !ok && !unowned[set]:
+				plan.Changes = append(plan.Changes, DNSRecordChange{Action: DNSRecordCreate, Desired: newDNSOwnershipMarker(set, opts.ManagedBy)})
+			case n == 0 && ok:
+				plan.Changes = append(plan.Changes, DNSRecordChange{Action: DNSRecordDelete, Existing: marker})
+			}
+		}
+	}
+
+	sortDNSRecordChanges(plan.Changes)
+	sortDNSRecordChanges(plan.Skipped)
+	return plan, nil
+}
+
+// normalizeDNSRecord puts a record's name, type, content and TTL into the
+// form the API returns, so that records can be compared.
+func normalizeDNSRecord(rr DNSRecord, zoneName string) DNSRecord {
+	rr.Type = strings.ToUpper(rr.Type)
+	name := canonicalZoneFileName(rr.Name)
+	switch {
+	case name == "@" || name == "":
+		name = zoneName
+	case name != zoneName && !strings.HasSuffix(name, "."+zoneName):
+		name = name + "." + zoneName
+	}
+	rr.Name = name
+
+	switch rr.Type {
+	case "A", "AAAA":
+		if ip := net.ParseIP(rr.Content); ip != nil {
+			rr.Content = ip.String()
+		}
+	case "CNAME", "NS", "MX", "PTR":
+		rr.Content = canonicalZoneFileName(rr.Content)
+	}
+	if rr.TTL <= 0 {
+		rr.TTL = 1
+	}
+	if !dnsTypeProxiable(rr.Type) {
+		rr.Proxied = false
+	}
+	return rr
+}
+
+// dnsRecordKey returns the identity of a normalized record.
+func dnsRecordKey(rr DNSRecord) string {
+	return rr.Name + "\x00" + rr.Type + "\x00" + rr.Content
+}
+
+// dnsRecordDiff lists the differences between two normalized records that
+// call for an update.
+func dnsRecordDiff(existing, desired DNSRecord) []string {
+	var diff []string
+	if existing.Content != desired.Content {
+		diff = append(diff, fmt.Sprintf("content %q -> %q", existing.Content, desired.Content))
+	}
+	if existing.Proxied != desired.Proxied {
+		diff = append(diff, fmt.Sprintf("proxied %t -> %t", existing.Proxied, desired.Proxied))
+	}
+	if !desired.Proxied && existing.TTL != desired.TTL {
+		diff = append(diff, fmt.Sprintf("ttl %d -> %d", existing.TTL, desired.TTL))
+	}
+	switch desired.Type {
+	case "MX", "SRV", "URI":
+		if existing.Priority != desired.Priority {
+			diff = append(diff, fmt.Sprintf("priority %d -> %d", existing.Priority, desired.Priority))
+		}
+	}
+	return diff
+}
+
+// describeDNSRecord describes a record for plans and errors.
+func describeDNSRecord(rr DNSRecord) string {
+	s := fmt.Sprintf("%s %s %q ttl=%d", rr.Type, rr.Name, rr.Content, rr.TTL)
+	if rr.Proxied {
+		s += " proxied"
+	}
+	return s
+}
+
+// sortDNSRecordChanges sorts changes by the name, type and content of the
+// records they affect, keeping ownership markers with their records.
+func sortDNSRecordChanges(changes []DNSRecordChange) {
+	record := func(c DNSRecordChange) DNSRecord {
+		if c.Action == DNSRecordDelete {
+			return c.Existing
+		}
+		return c.Desired
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := record(changes[i]), record(changes[j])
+		ka := zoneFileSortKey(strings.TrimPrefix(a.Name, dnsOwnershipLabel+"."))
+		kb := zoneFileSortKey(strings.TrimPrefix(b.Name, dnsOwnershipLabel+"."))
+		switch {
+		case ka != kb:
+			return ka < kb
+		case a.Name != b.Name:
+			return a.Name < b.Name
+		case a.Type != b.Type:
+			return a.Type < b.Type
+		}
+		return a.Content < b.Content
+	})
+}
+
+func sortedDNSRecordSets(sets map[dnsRecordSet]bool) []dnsRecordSet {
+	sorted := make([]dnsRecordSet, 0, len(sets))
+	for set := range sets {
+		sorted = append(sorted, set)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].name != sorted[j].name {
+			return zoneFileSortKey(sorted[i].name) < zoneFileSortKey(sorted[j].name)
+		}
+		return sorted[i].rrType < sorted[j].rrType
+	})
+	return sorted
+}
+
+// newDNSOwnershipMarker returns the TXT record that marks the records of set
+// as managed by owner.
+func newDNSOwnershipMarker(set dnsRecordSet, owner string) DNSRecord {
+	return DNSRecord{
+		Type:    "TXT",
+		Name:    dnsOwnershipLabel + "." + set.name,
+		Content: fmt.Sprintf("managed-by=%s,type=%s", owner, set.rrType),
+		TTL:     1,
+	}
+}
+
+// parseDNSOwnershipMarker reports whether rr is an ownership marker, and if
+// so, which records it marks and who owns them.
+func parseDNSOwnershipMarker(rr DNSRecord) (dnsRecordSet, string, bool) {
+	if rr.Type != "TXT" || !strings.HasPrefix(rr.Name, dnsOwnershipLabel+".") {
+		return dnsRecordSet{}, "", false
+	}
+	content := strings.TrimPrefix(rr.Content, "managed-by=")
+	i := strings.LastIndex(content, ",type=")
+	if content == rr.Content || i < 0 {
+		return dnsRecordSet{}, "", false
+	}
+	set := dnsRecordSet{
+		name:   strings.TrimPrefix(rr.Name, dnsOwnershipLabel+"."),
+		rrType: content[i+len(",type="):],
+	}
+	return set, content[:i], true
+}
+
+// dnsTypeProxiable reports whether records of a type can be proxied.
+func dnsTypeProxiable(rrType string) bool {
+	return rrType == "A" || rrType == "AAAA" || rrType == "CNAME"
+}
@@ -0,0 +1,157 @@
+package cloudflare_test
+
+import (
+	"sort"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/cloudflare-go/cftest"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSyncServer returns a fake API server and a client for it.
+func newSyncServer(t *testing.T) (*cftest.Server, *cloudflare.API) {
+	srv := cftest.NewServer()
+	api, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, api
+}
+
+// syncedRecords returns the records of a zone as "TYPE name content" strings.
+func syncedRecords(srv *cftest.Server, zoneID string) []string {
+	var out []string
+	for _, rr := range srv.DNSRecords(zoneID) {
+		out = append(out, rr.Type+" "+rr.Name+" "+rr.Content)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestDNSSync(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "example.com", Content: "192.0.2.1", Proxied: true, TTL: 1})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.2", TTL: 300})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "AAAA", Name: "www.example.com", Content: "2001:db8::1", TTL: 300})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "old.example.com", Content: "remove me", TTL: 1})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "MX", Name: "example.com", Content: "mx.example.com", Priority: 10, TTL: 1})
+
+	desired := []cloudflare.DNSRecord{
+		// A proxied record's TTL is always automatic, so 300 is no change.
+		{Type: "A", Name: "@", Content: "192.0.2.1", Proxied: true, TTL: 300},
+		// TTL 0 means automatic, so this changes the TTL.
+		{Type: "A", Name: "www", Content: "192.0.2.2"},
+		// New content for an existing name and type becomes an update.
+		{Type: "aaaa", Name: "www.example.com.", Content: "2001:0db8:0:0:0:0:0:2", TTL: 300},
+		{Type: "MX", Name: "@", Content: "MX.example.com.", Priority: 20},
+		{Type: "CNAME", Name: "blog", Content: "example.com", Proxied: true},
+	}
+
+	plan, err := api.PlanDNSSync(zone.ID, desired, cloudflare.DNSSyncOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `~ MX example.com "mx.example.com" ttl=1 (priority 10 -> 20)
++ CNAME blog.example.com "example.com" ttl=1 proxied
+- TXT old.example.com "remove me" ttl=1
+~ A www.example.com "192.0.2.2" ttl=300 (ttl 300 -> 1)
+~ AAAA www.example.com "2001:db8::1" ttl=300 (content "2001:db8::1" -> "2001:db8::2")
+`, plan.String())
+
+	if !assert.NoError(t, api.ApplyDNSSyncPlan(plan)) {
+		return
+	}
+	assert.Equal(t, []string{
+		"A example.com 192.0.2.1",
+		"A www.example.com 192.0.2.2",
+		"AAAA www.example.com 2001:db8::2",
+		"CNAME blog.example.com example.com",
+		"MX example.com mx.example.com",
+	}, syncedRecords(srv, zone.ID))
+
+	plan, err = api.PlanDNSSync(zone.ID, desired, cloudflare.DNSSyncOptions{})
+	if assert.NoError(t, err) {
+		assert.True(t, plan.Empty(), plan.String())
+	}
+}
+
+func TestDNSSync_NoDeletes(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "example.com", Content: "keep me"})
+
+	desired := []cloudflare.DNSRecord{{Type: "A", Name: "www", Content: "192.0.2.2"}}
+	plan, err := api.PlanDNSSync(zone.ID, desired, cloudflare.DNSSyncOptions{NoDeletes: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `+ A www.example.com "192.0.2.2" ttl=1
+! - TXT example.com "keep me" ttl=1: deletes disabled
+! - A www.example.com "192.0.2.1" ttl=1: deletes disabled
+`, plan.String())
+
+	assert.NoError(t, api.ApplyDNSSyncPlan(plan))
+	assert.Len(t, srv.DNSRecords(zone.ID), 3)
+}
+
+func TestDNSSync_ManagedBy(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "example.com", Content: "not ours"})
+	srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "legacy.example.com", Content: "192.0.2.9"})
+
+	opts := cloudflare.DNSSyncOptions{ManagedBy: "gitops"}
+	desired := []cloudflare.DNSRecord{
+		{Type: "A", Name: "www", Content: "192.0.2.1"},
+		{Type: "A", Name: "legacy", Content: "192.0.2.10"},
+	}
+	plan, err := api.PlanDNSSync(zone.ID, desired, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `+ TXT _managed-by.www.example.com "managed-by=gitops,type=A" ttl=1
++ A www.example.com "192.0.2.1" ttl=1
+! + A legacy.example.com "192.0.2.10" ttl=1: A records at legacy.example.com are not managed by gitops
+`, plan.String())
+	if !assert.NoError(t, api.ApplyDNSSyncPlan(plan)) {
+		return
+	}
+
+	// Dropping www deletes it along with its marker, and leaves the records
+	// that are not ours alone.
+	plan, err = api.PlanDNSSync(zone.ID, nil, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `- TXT _managed-by.www.example.com "managed-by=gitops,type=A" ttl=1
+- A www.example.com "192.0.2.1" ttl=1
+`, plan.String())
+	if !assert.NoError(t, api.ApplyDNSSyncPlan(plan)) {
+		return
+	}
+	assert.Equal(t, []string{
+		"A legacy.example.com 192.0.2.9",
+		"TXT example.com not ours",
+	}, syncedRecords(srv, zone.ID))
+}
+
+func TestDNSSync_DuplicateDesired(t *testing.T) {
+	srv, api := newSyncServer(t)
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	_, err := api.PlanDNSSync(zone.ID, []cloudflare.DNSRecord{
+		{Type: "A", Name: "www", Content: "192.0.2.1"},
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300},
+	}, cloudflare.DNSSyncOptions{})
+	assert.Error(t, err)
+}
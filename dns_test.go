@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var queries []url.Values
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		queries = append(queries, r.URL.Query())
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b5%s",
+					"type": "CNAME",
+					"name": "www%s.example.com",
+					"content": "example.com",
+					"proxied": true,
+					"ttl": 1
+				}
+			],
+			"result_info": {
+				"page": %s,
+				"per_page": 1,
+				"count": 1,
+				"total_count": 2
+			}
+		}`, page, page, page)
+	})
+
+	proxied := true
+	records, err := client.ListDNSRecords("023e105f4ecef8ad9ca31a8372d0c353", DNSListOptions{
+		Type:           "CNAME",
+		NameStartsWith: "www",
+		NameContains:   "example",
+		Proxied:        &proxied,
+		Match:          "all",
+		Order:          "name",
+		Direction:      "desc",
+		PerPage:        1,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "www1.example.com", records[0].Name)
+		assert.Equal(t, "www2.example.com", records[1].Name)
+	}
+
+	if assert.Len(t, queries, 2) {
+		want := url.Values{
+			"type":            {"CNAME"},
+			"name.startswith": {"www"},
+			"name.contains":   {"example"},
+			"proxied":         {"true"},
+			"match":           {"all"},
+			"order":           {"name"},
+			"direction":       {"desc"},
+			"per_page":        {"1"},
+			"page":            {"1"},
+		}
+		assert.Equal(t, want, queries[0])
+		assert.Equal(t, "2", queries[1].Get("page"))
+	}
+}
+
+func TestListDNSRecords_DefaultPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		assert.Equal(t, "false", r.URL.Query().Get("proxied"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [], "result_info": {"page": 1, "per_page": 50, "count": 0, "total_count": 0}}`)
+	})
+
+	proxied := false
+	records, err := client.ListDNSRecords("023e105f4ecef8ad9ca31a8372d0c353", DNSListOptions{Proxied: &proxied})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestListDNSRecords_InvalidOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	for _, opts := range []DNSListOptions{
+		{Match: "some"},
+		{Order: "priority"},
+		{Direction: "up"},
+	} {
+		_, err := client.ListDNSRecords("023e105f4ecef8ad9ca31a8372d0c353", opts)
+		assert.Error(t, err, "%+v", opts)
+
+		it := client.ListDNSRecordsIter(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", opts)
+		assert.False(t, it.Next())
+		assert.Error(t, it.Err())
+	}
+	assert.Equal(t, 0, requests)
+}
@@ -0,0 +1,611 @@
+package cloudflare
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// zoneFileProxiedTag marks a proxied record in a zone file comment, as in
+// zone files exported from the Cloudflare dashboard:
+//
+//	www	300	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+const zoneFileProxiedTag = "cf-proxied:true"
+
+// ParseZoneFile reads DNS records from an RFC 1035 zone file, such as one
+// exported from a BIND server. Relative names are qualified with origin,
+// which may be empty if the file sets $ORIGIN before using relative names.
+//
+// The $ORIGIN and $TTL directives are supported; $INCLUDE is not. Records
+// without a TTL use the $TTL value, or else the TTL of the record before
+// them, or else 1 (automatic). Records whose comment contains
+// "cf-proxied:true" are marked as proxied.
+//
+// Names in the returned records are fully qualified, without a trailing dot,
+// as the API expects. The SOA record and NS records at the zone apex are
+// skipped, since Cloudflare manages them. The Data of SRV and CAA records is
+// set to an SRVRecordData or CAARecordData so the records can be passed to
+// CreateDNSRecord.
+func ParseZoneFile(r io.Reader, origin string) ([]DNSRecord, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read zone file")
+	}
+	entries, err := scanZoneFile(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	p := zoneFileParser{origin: canonicalZoneFileName(origin)}
+	records := []DNSRecord{}
+	for _, e := range entries {
+		rr, ok, err := p.parse(e)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zone file line %d", e.line)
+		}
+		if ok {
+			records = append(records, rr)
+		}
+	}
+	return records, nil
+}
+
+// WriteZoneFile writes records to w as an RFC 1035 zone file for the zone
+// origin. Names within the zone are written relative to it. Proxied records
+// are marked with a "cf-proxied:true" comment so that ParseZoneFile, and the
+// Cloudflare dashboard, can restore them. Records are sorted in DNS order,
+// with the apex first and each name followed by its subdomains.
+func WriteZoneFile(w io.Writer, origin string, records []DNSRecord) error {
+	origin = canonicalZoneFileName(origin)
+	sorted := make([]DNSRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ki, kj := zoneFileSortKey(sorted[i].Name), zoneFileSortKey(sorted[j].Name)
+		if ki != kj {
+			return ki < kj
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n\n", origin)
+	for _, r := range sorted {
+		rdata, err := zoneFileRData(r)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s record %s", r.Type, r.Name)
+		}
+		ttl := r.TTL
+		if ttl <= 0 {
+			ttl = 1
+		}
+		fmt.Fprintf(bw, "%s\t%d\tIN\t%s\t%s", relativeZoneFileName(r.Name, origin), ttl, r.Type, rdata)
+		if r.Proxied {
+			fmt.Fprintf(bw, " ; cf_tags=%s", zoneFileProxiedTag)
+		}
+		fmt.Fprintln(bw)
+	}
+	return bw.Flush()
+}
+
+// zoneFileToken is a word or quoted string in a zone file.
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is a logical line of a zone file: a record or directive,
+// which may span several lines in parentheses.
+type zoneFileEntry struct {
+	line     int
+	indented bool
+	tokens   []zoneFileToken
+	comment  string
+}
+
+// scanZoneFile splits a zone file into entries, resolving escapes and
+// collecting comments.
+func scanZoneFile(data string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	line, depth := 1, 0
+	entry := zoneFileEntry{line: line, indented: strings.HasPrefix(data, " ") || strings.HasPrefix(data, "\t")}
+
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			if depth == 0 {
+				if len(entry.tokens) > 0 {
+					entries = append(entries, entry)
+				}
+				entry = zoneFileEntry{line: line, indented: i < len(data) && (data[i] == ' ' || data[i] == '\t')}
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == ';':
+			end := strings.IndexByte(data[i:], '\n')
+			if end < 0 {
+				end = len(data)
+			} else {
+				end += i
+			}
+			entry.comment += strings.TrimSpace(data[i+1:end]) + " "
+			i = end
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			if depth == 0 {
+				return nil, errors.Errorf("zone file line %d: unbalanced parentheses", line)
+			}
+			depth--
+			i++
+		default:
+			quoted := c == '"'
+			text, next, err := readZoneFileString(data, i, quoted)
+			if err != nil {
+				return nil, errors.Wrapf(err, "zone file line %d", line)
+			}
+			line += strings.Count(data[i:next], "\n")
+			entry.tokens = append(entry.tokens, zoneFileToken{text: text, quoted: quoted})
+			i = next
+		}
+	}
+	if depth != 0 {
+		return nil, errors.Errorf("zone file line %d: unbalanced parentheses", entry.line)
+	}
+	if len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readZoneFileString reads a word, or a quoted string if quoted, starting at
+// data[i]. It returns the text with escapes resolved and the index just past
+// it.
+func readZoneFileString(data string, i int, quoted bool) (string, int, error) {
+	var sb strings.Builder
+	if quoted {
+		i++
+	}
+	for i < len(data) {
+		c := data[i]
+		switch {
+		case quoted && c == '"':
+			return sb.String(), i + 1, nil
+		case !quoted && strings.IndexByte(" \t\r\n();\"", c) >= 0:
+			return sb.String(), i, nil
+		case c == '\\' && i+1 < len(data):
+			// \DDD is a decimal byte value, and \X is a literal X.
+			if i+3 < len(data) && isDigits(data[i+1:i+4]) {
+				n, _ := strconv.Atoi(data[i+1 : i+4])
+				if n > 255 {
+					return "", i, errors.Errorf("invalid escape %q", data[i:i+4])
+				}
+				sb.WriteByte(byte(n))
+				i += 4
+				continue
+			}
+			sb.WriteByte(data[i+1])
+			i += 2
+			continue
+		}
+		sb.WriteByte(c)
+		i++
+	}
+	if quoted {
+		return "", i, errors.New("unterminated quoted string")
+	}
+	return sb.String(), i, nil
+}
+
+// zoneFileParser turns zone file entries into records, tracking the state
+// set by directives and earlier records.
+type zoneFileParser struct {
+	origin     string
+	defaultTTL int
+	lastTTL    int
+	owner      string
+}
+
+// parse parses a single entry. It reports false for directives and skipped
+// records.
+func (p *zoneFileParser) parse(e zoneFileEntry) (DNSRecord, bool, error) {
+	toks := e.tokens
+	if first := toks[0]; !first.quoted && strings.HasPrefix(first.text, "$") {
+		return DNSRecord{}, false, p.directive(toks)
+	}
+
+	if !e.indented {
+		owner, err := p.qualify(toks[0].text)
+		if err != nil {
+			return DNSRecord{}, false, err
+		}
+		p.owner = owner
+		toks = toks[1:]
+	} else if p.owner == "" {
+		return DNSRecord{}, false, errors.New("record has no owner name")
+	}
+
+	// The TTL and class are both optional, and may come in either order.
+	ttl, class := -1, ""
+	for len(toks) > 0 && !toks[0].quoted {
+		if v, ok := parseZoneFileTTL(toks[0].text); ok && ttl < 0 {
+			ttl = v
+		} else if isZoneFileClass(toks[0].text) && class == "" {
+			class = strings.ToUpper(toks[0].text)
+		} else {
+			break
+		}
+		toks = toks[1:]
+	}
+	if len(toks) == 0 {
+		return DNSRecord{}, false, errors.New("missing record type")
+	}
+	if class != "" && class != "IN" {
+		return DNSRecord{}, false, errors.Errorf("unsupported class %s", class)
+	}
+	switch {
+	case ttl >= 0:
+		p.lastTTL = ttl
+	case p.defaultTTL > 0:
+		ttl = p.defaultTTL
+	case p.lastTTL > 0:
+		ttl = p.lastTTL
+	default:
+		ttl = 1
+	}
+
+	rr := DNSRecord{
+		Type:    strings.ToUpper(toks[0].text),
+		Name:    p.owner,
+		TTL:     ttl,
+		Proxied: strings.Contains(e.comment, zoneFileProxiedTag),
+	}
+	rdata := toks[1:]
+	if len(rdata) == 0 {
+		return DNSRecord{}, false, errors.Errorf("missing data for %s record", rr.Type)
+	}
+
+	var err error
+	switch rr.Type {
+	case "SOA":
+		return DNSRecord{}, false, nil
+	case "NS":
+		if rr.Name == p.origin {
+			return DNSRecord{}, false, nil
+		}
+		rr.Content, err = p.target(rr.Type, rdata)
+	case "CNAME", "PTR":
+		rr.Content, err = p.target(rr.Type, rdata)
+	case "MX":
+		err = p.mx(&rr, rdata)
+	case "SRV":
+		err = p.srv(&rr, rdata)
+	case "CAA":
+		err = p.caa(&rr, rdata)
+	case "TXT", "SPF":
+		// Quoted strings are concatenated, as for SPF (RFC 7208), but
+		// unquoted words keep the spaces between them.
+		var sb strings.Builder
+		for i, t := range rdata {
+			if i > 0 && !(t.quoted && rdata[i-1].quoted) {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(t.text)
+		}
+		rr.Content = sb.String()
+	default:
+		texts := make([]string, len(rdata))
+		for i, t := range rdata {
+			texts[i] = t.text
+		}
+		rr.Content = strings.Join(texts, " ")
+	}
+	if err != nil {
+		return DNSRecord{}, false, err
+	}
+	return rr, true, nil
+}
+
+// directive applies a $ORIGIN or $TTL directive.
+func (p *zoneFileParser) directive(toks []zoneFileToken) error {
+	name := strings.ToUpper(toks[0].text)
+	if len(toks) != 2 {
+		return errors.Errorf("%s takes one argument", name)
+	}
+	switch name {
+	case "$ORIGIN":
+		origin, err := p.qualify(toks[1].text)
+		if err != nil {
+			return err
+		}
+		p.origin = origin
+	case "$TTL":
+		ttl, ok := parseZoneFileTTL(toks[1].text)
+		if !ok {
+			return errors.Errorf("invalid TTL %q", toks[1].text)
+		}
+		p.defaultTTL = ttl
+	default:
+		return errors.Errorf("unsupported directive %s", name)
+	}
+	return nil
+}
+
+// qualify returns the fully qualified form of a name, without a trailing dot.
+func (p *zoneFileParser) qualify(name string) (string, error) {
+	if strings.HasSuffix(name, ".") {
+		return canonicalZoneFileName(name), nil
+	}
+	if p.origin == "" {
+		return "", errors.Errorf("relative name %q with no origin", name)
+	}
+	if name == "@" {
+		return p.origin, nil
+	}
+	return strings.ToLower(name) + "." + p.origin, nil
+}
+
+// target parses the data of a record that holds a single name.
+func (p *zoneFileParser) target(rtype string, rdata []zoneFileToken) (string, error) {
+	if len(rdata) != 1 {
+		return "", errors.Errorf("%s record needs a single target", rtype)
+	}
+	return p.qualify(rdata[0].text)
+}
+
+func (p *zoneFileParser) mx(rr *DNSRecord, rdata []zoneFileToken) error {
+	if len(rdata) != 2 {
+		return errors.New("MX record needs a preference and an exchange")
+	}
+	pref, err := parseZoneFileUint16("MX preference", rdata[0].text)
+	if err != nil {
+		return err
+	}
+	rr.Priority = pref
+	rr.Content, err = p.qualify(rdata[1].text)
+	return err
+}
+
+func (p *zoneFileParser) srv(rr *DNSRecord, rdata []zoneFileToken) error {
+	if len(rdata) != 4 {
+		return errors.New("SRV record needs a priority, weight, port and target")
+	}
+	labels := strings.SplitN(rr.Name, ".", 3)
+	if len(labels) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return errors.Errorf("SRV record name %q is not of the form _service._proto.name", rr.Name)
+	}
+	var nums [3]int
+	for i, field := range []string{"SRV priority", "SRV weight", "SRV port"} {
+		n, err := parseZoneFileUint16(field, rdata[i].text)
+		if err != nil {
+			return err
+		}
+		nums[i] = n
+	}
+	target, err := p.qualify(rdata[3].text)
+	if err != nil {
+		return err
+	}
+
+	rr.Priority = nums[0]
+	rr.Content = fmt.Sprintf("%d\t%d\t%s", nums[1], nums[2], target)
+	return rr.SetData(SRVRecordData{
+		Service:  labels[0],
+		Proto:    labels[1],
+		Name:     labels[2],
+		Priority: nums[0],
+		Weight:   nums[1],
+		Port:     nums[2],
+		Target:   target,
+	})
+}
+
+func (p *zoneFileParser) caa(rr *DNSRecord, rdata []zoneFileToken) error {
+	if len(rdata) != 3 {
+		return errors.New("CAA record needs flags, a tag and a value")
+	}
+	flags, err := strconv.ParseUint(rdata[0].text, 10, 8)
+	if err != nil {
+		return errors.Errorf("invalid CAA flags %q", rdata[0].text)
+	}
+	tag, value := rdata[1].text, rdata[2].text
+	rr.Content = fmt.Sprintf("%d %s %s", flags, tag, quoteZoneFileString(value))
+	return rr.SetData(CAARecordData{Flags: int(flags), Tag: tag, Value: value})
+}
+
+// zoneFileRData returns the data of a record in zone file form.
+func zoneFileRData(r DNSRecord) (string, error) {
+	switch r.Type {
+	case "CNAME", "NS", "PTR":
+		return absoluteZoneFileName(r.Content)
+	case "MX":
+		target, err := absoluteZoneFileName(r.Content)
+		return fmt.Sprintf("%d %s", r.Priority, target), err
+	case "SRV":
+		return srvZoneFileRData(r)
+	case "CAA":
+		return caaZoneFileRData(r)
+	case "TXT", "SPF":
+		// Character strings are limited to 255 bytes, so split long text
+		// into several strings.
+		var parts []string
+		content := r.Content
+		for len(content) > 255 {
+			parts = append(parts, quoteZoneFileString(content[:255]))
+			content = content[255:]
+		}
+		parts = append(parts, quoteZoneFileString(content))
+		return strings.Join(parts, " "), nil
+	}
+	if r.Content == "" {
+		return "", errors.New("record has no content")
+	}
+	return r.Content, nil
+}
+
+// srvZoneFileRData formats an SRV record from its Data if it has one, and
+// from its priority and content, which the API returns as weight, port and
+// target, otherwise.
+func srvZoneFileRData(r DNSRecord) (string, error) {
+	var d SRVRecordData
+	if r.Data != nil && r.DecodeData(&d) == nil && d.Target != "" {
+		target, err := absoluteZoneFileName(d.Target)
+		return fmt.Sprintf("%d %d %d %s", d.Priority, d.Weight, d.Port, target), err
+	}
+	fields := strings.Fields(r.Content)
+	if len(fields) == 4 {
+		fields = fields[1:]
+	}
+	if len(fields) != 3 {
+		return "", errors.Errorf("unexpected content %q", r.Content)
+	}
+	target, err := absoluteZoneFileName(fields[2])
+	return fmt.Sprintf("%d %s %s %s", r.Priority, fields[0], fields[1], target), err
+}
+
+// caaZoneFileRData formats a CAA record from its Data if it has one, and
+// from its content otherwise.
+func caaZoneFileRData(r DNSRecord) (string, error) {
+	var d CAARecordData
+	if r.Data != nil && r.DecodeData(&d) == nil && d.Tag != "" {
+		return fmt.Sprintf("%d %s %s", d.Flags, d.Tag, quoteZoneFileString(d.Value)), nil
+	}
+	fields := strings.SplitN(strings.TrimSpace(r.Content), " ", 3)
+	if len(fields) != 3 {
+		return "", errors.Errorf("unexpected content %q", r.Content)
+	}
+	value := fields[2]
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	return fmt.Sprintf("%s %s %s", fields[0], fields[1], quoteZoneFileString(value)), nil
+}
+
+// quoteZoneFileString quotes s as a zone file character string.
+func quoteZoneFileString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&sb, "\\%03d", c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// zoneFileSortKey returns name with its labels reversed, so that names sort
+// in DNS order.
+func zoneFileSortKey(name string) string {
+	labels := strings.Split(canonicalZoneFileName(name), ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
+
+// canonicalZoneFileName returns name in the form the API uses: lower case,
+// without a trailing dot.
+func canonicalZoneFileName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// absoluteZoneFileName returns name with a trailing dot.
+func absoluteZoneFileName(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("record has no target")
+	}
+	if strings.HasSuffix(name, ".") {
+		return name, nil
+	}
+	return name + ".", nil
+}
+
+// relativeZoneFileName returns name relative to origin if it is within it,
+// and absolute otherwise.
+func relativeZoneFileName(name, origin string) string {
+	name = canonicalZoneFileName(name)
+	switch {
+	case name == origin:
+		return "@"
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name + "."
+}
+
+// parseZoneFileTTL parses a TTL in seconds, or in BIND's unit form such as
+// "1h30m".
+func parseZoneFileTTL(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if isDigits(s) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, n, digits := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			n = n*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		total += n * unit
+		n, digits = 0, false
+	}
+	if digits {
+		return 0, false
+	}
+	return total, true
+}
+
+func parseZoneFileUint16(field, s string) (int, error) {
+	n, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s %q", field, s)
+	}
+	return int(n), nil
+}
+
+func isZoneFileClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package cloudflare
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2019010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+@		IN	NS	ns1.example.com.
+@		IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+		IN	AAAA	2001:db8::1
+www	300	IN	CNAME	@
+mail		IN	MX	10 mx1
+		IN	MX	20 mx2.example.net.
+_sip._tcp	IN	SRV	10 5 5060 sip
+@		IN	CAA	0 issue "letsencrypt.org"
+@		IN	TXT	"v=spf1 include:_spf.example.net" " ~all"
+quote		IN	TXT	"say \"hi\"\059 ok"
+spf		IN	TXT	v=spf1 include:_spf.example.com ~all
+sub		IN	NS	ns.sub
+$ORIGIN dev.example.com.
+api	60	IN	A	192.0.2.2
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := ParseZoneFile(strings.NewReader(testZoneFile), "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	want := []DNSRecord{
+		{Type: "A", Name: "example.com", Content: "192.0.2.1", TTL: 3600, Proxied: true},
+		{Type: "AAAA", Name: "example.com", Content: "2001:db8::1", TTL: 3600},
+		{Type: "CNAME", Name: "www.example.com", Content: "example.com", TTL: 300},
+		{Type: "MX", Name: "mail.example.com", Content: "mx1.example.com", Priority: 10, TTL: 3600},
+		{Type: "MX", Name: "mail.example.com", Content: "mx2.example.net", Priority: 20, TTL: 3600},
+		{Type: "SRV", Name: "_sip._tcp.example.com", Content: "5\t5060\tsip.example.com", Priority: 10, TTL: 3600, Data: SRVRecordData{
+			Service: "_sip", Proto: "_tcp", Name: "example.com", Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com",
+		}},
+		{Type: "CAA", Name: "example.com", Content: `0 issue "letsencrypt.org"`, TTL: 3600, Data: CAARecordData{
+			Flags: 0, Tag: "issue", Value: "letsencrypt.org",
+		}},
+		{Type: "TXT", Name: "example.com", Content: "v=spf1 include:_spf.example.net ~all", TTL: 3600},
+		{Type: "TXT", Name: "quote.example.com", Content: `say "hi"; ok`, TTL: 3600},
+		{Type: "TXT", Name: "spf.example.com", Content: "v=spf1 include:_spf.example.com ~all", TTL: 3600},
+		{Type: "NS", Name: "sub.example.com", Content: "ns.sub.example.com", TTL: 3600},
+		{Type: "A", Name: "api.dev.example.com", Content: "192.0.2.2", TTL: 60},
+	}
+	assert.Equal(t, want, records)
+}
+
+func TestParseZoneFile_DefaultTTL(t *testing.T) {
+	records, err := ParseZoneFile(strings.NewReader("a 300 IN A 192.0.2.1\nb IN A 192.0.2.2\n"), "example.com.")
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, 300, records[1].TTL)
+	}
+
+	records, err = ParseZoneFile(strings.NewReader("a IN A 192.0.2.1\n"), "example.com")
+	if assert.NoError(t, err) && assert.Len(t, records, 1) {
+		assert.Equal(t, 1, records[0].TTL)
+		assert.Equal(t, "a.example.com", records[0].Name)
+	}
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	tests := map[string]string{
+		"no origin":          "www IN A 192.0.2.1\n",
+		"no owner":           "\tIN A 192.0.2.1\n",
+		"bad class":          "$ORIGIN example.com.\nwww CH A 192.0.2.1\n",
+		"missing type":       "$ORIGIN example.com.\nwww 300 IN\n",
+		"missing data":       "$ORIGIN example.com.\nwww IN A\n",
+		"bad MX":             "$ORIGIN example.com.\n@ IN MX mx1\n",
+		"bad SRV name":       "$ORIGIN example.com.\nsip IN SRV 10 5 5060 sip\n",
+		"bad SRV port":       "$ORIGIN example.com.\n_sip._tcp IN SRV 10 5 70000 sip\n",
+		"bad CAA":            "$ORIGIN example.com.\n@ IN CAA 0 issue\n",
+		"include":            "$INCLUDE other.zone\n",
+		"unterminated":       "$ORIGIN example.com.\n@ IN TXT \"oops\n",
+		"unbalanced":         "$ORIGIN example.com.\n@ IN SOA ns1 hostmaster ( 1 2 3 4 5\n",
+		"unbalanced closing": "$ORIGIN example.com.\n@ IN A 192.0.2.1 )\n",
+	}
+	for name, zone := range tests {
+		_, err := ParseZoneFile(strings.NewReader(zone), "")
+		assert.Error(t, err, name)
+	}
+
+	_, err := ParseZoneFile(strings.NewReader("$ORIGIN example.com.\n\n@ IN MX mx1\n"), "")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "line 3")
+	}
+}
+
+func TestWriteZoneFile(t *testing.T) {
+	records := []DNSRecord{
+		{Type: "TXT", Name: "example.com", Content: `v=spf1 "quoted" -all`, TTL: 1},
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{Type: "MX", Name: "example.com", Content: "mx.example.net", Priority: 10, TTL: 300},
+		// As returned by the API.
+		{Type: "SRV", Name: "_sip._tcp.example.com", Content: "5\t5060\tsip.example.com", Priority: 10, TTL: 300, Data: map[string]interface{}{
+			"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": 10.0, "weight": 5.0, "port": 5060.0, "target": "sip.example.com",
+		}},
+		{Type: "CAA", Name: "example.com", Content: "0 issue letsencrypt.org", TTL: 300},
+		{Type: "CNAME", Name: "other.example.org", Content: "example.com", TTL: 300},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteZoneFile(&buf, "example.com", records))
+	assert.Equal(t, `$ORIGIN example.com.
+
+@	300	IN	CAA	0 issue "letsencrypt.org"
+@	300	IN	MX	10 mx.example.net.
+@	1	IN	TXT	"v=spf1 \"quoted\" -all"
+_sip._tcp	300	IN	SRV	10 5 5060 sip.example.com.
+www	1	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+other.example.org.	300	IN	CNAME	example.com.
+`, buf.String())
+}
+
+func TestZoneFileRoundTrip(t *testing.T) {
+	records, err := ParseZoneFile(strings.NewReader(testZoneFile), "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	long := DNSRecord{Type: "TXT", Name: "long.example.com", Content: strings.Repeat("0123456789", 30), TTL: 1}
+	records = append(records, long)
+
+	var buf bytes.Buffer
+	if !assert.NoError(t, WriteZoneFile(&buf, "example.com.", records)) {
+		return
+	}
+	assert.Contains(t, buf.String(), `" "`)
+
+	parsed, err := ParseZoneFile(&buf, "")
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, records, parsed)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	tests := map[string]int{"0": 0, "3600": 3600, "1h": 3600, "1h30m": 5400, "1W": 604800, "2d12h": 216000}
+	for s, want := range tests {
+		ttl, ok := parseZoneFileTTL(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, want, ttl, s)
+	}
+	for _, s := range []string{"", "h", "1x", "10m5", "IN"} {
+		_, ok := parseZoneFileTTL(s)
+		assert.False(t, ok, s)
+	}
+}
@@ -0,0 +1,164 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneDNSSEC is the DNSSEC configuration of a zone. Status is "active",
+// "pending", "disabled", "pending-disabled" or "error". The key and DS
+// fields are only set once DNSSEC has been enabled.
+type ZoneDNSSEC struct {
+	Status          string    `json:"status"`
+	Flags           int       `json:"flags"`
+	Algorithm       string    `json:"algorithm"`
+	KeyType         string    `json:"key_type"`
+	DigestType      string    `json:"digest_type"`
+	DigestAlgorithm string    `json:"digest_algorithm"`
+	Digest          string    `json:"digest"`
+	DS              string    `json:"ds"`
+	KeyTag          int       `json:"key_tag"`
+	PublicKey       string    `json:"public_key"`
+	ModifiedOn      time.Time `json:"modified_on"`
+}
+
+// ZoneDNSSECUpdateOptions are the DNSSEC settings that can be changed. Set
+// Status to "active" to enable DNSSEC and "disabled" to disable it.
+type ZoneDNSSECUpdateOptions struct {
+	Status string `json:"status"`
+}
+
+// ZoneDNSSECResponse represents the response from the zone DNSSEC endpoint.
+type ZoneDNSSECResponse struct {
+	Response
+	Result ZoneDNSSEC `json:"result"`
+}
+
+// DSRecord returns the DS record to add to the parent zone, such as at the
+// registrar, parsed from the DS field. Its Data is a DSRecordData.
+func (d ZoneDNSSEC) DSRecord() (DNSRecord, error) {
+	if d.DS == "" {
+		return DNSRecord{}, errors.New("zone has no DS record; is DNSSEC enabled?")
+	}
+	records, err := ParseZoneFile(strings.NewReader(d.DS), "")
+	if err != nil {
+		return DNSRecord{}, errors.Wrap(err, "invalid DS record")
+	}
+	if len(records) != 1 || records[0].Type != "DS" {
+		return DNSRecord{}, errors.Errorf("invalid DS record %q", d.DS)
+	}
+	rr := records[0]
+
+	fields := strings.Fields(rr.Content)
+	if len(fields) < 4 {
+		return DNSRecord{}, errors.Errorf("invalid DS record %q", d.DS)
+	}
+	var nums [3]int
+	for i := range nums {
+		if nums[i], err = strconv.Atoi(fields[i]); err != nil {
+			return DNSRecord{}, errors.Errorf("invalid DS record %q", d.DS)
+		}
+	}
+	data := DSRecordData{
+		KeyTag:     nums[0],
+		Algorithm:  nums[1],
+		DigestType: nums[2],
+		Digest:     strings.Join(fields[3:], ""),
+	}
+	if err := rr.SetData(data); err != nil {
+		return DNSRecord{}, err
+	}
+	return rr, nil
+}
+
+// DNSKEY returns the zone's public key signing key as DNSKEY record data.
+func (d ZoneDNSSEC) DNSKEY() (DNSKEYRecordData, error) {
+	algorithm, err := strconv.Atoi(d.Algorithm)
+	if err != nil {
+		return DNSKEYRecordData{}, errors.Errorf("invalid DNSSEC algorithm %q", d.Algorithm)
+	}
+	key := DNSKEYRecordData{
+		Flags:     d.Flags,
+		Protocol:  3,
+		Algorithm: algorithm,
+		PublicKey: d.PublicKey,
+	}
+	if err := key.Validate(); err != nil {
+		return DNSKEYRecordData{}, err
+	}
+	return key, nil
+}
+
+// DSRecordData is the data of a DS record (RFC 4034). Digest is hex
+// encoded.
+type DSRecordData struct {
+	KeyTag     int    `json:"key_tag"`
+	Algorithm  int    `json:"algorithm"`
+	DigestType int    `json:"digest_type"`
+	Digest     string `json:"digest"`
+}
+
+// RecordType returns "DS".
+func (DSRecordData) RecordType() string { return "DS" }
+
+// Validate checks the ranges of the key tag, algorithm and digest type, and
+// that the digest is hex of the right length for SHA-1 (1), SHA-256 (2) and
+// SHA-384 (4) digests.
+func (d DSRecordData) Validate() error {
+	if err := checkDNSRecordDataRanges(d, []dnsRecordDataRange{
+		{"key tag", d.KeyTag, 0, 65535},
+		{"algorithm", d.Algorithm, 0, 255},
+		{"digest type", d.DigestType, 0, 255},
+	}); err != nil {
+		return err
+	}
+	return checkDNSRecordDataHex(d, "digest", d.Digest, map[int]int{1: 20, 2: 32, 4: 48}[d.DigestType])
+}
+
+// ZoneDNSSECSetting returns the DNSSEC configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#dnssec-dnssec-details
+func (api *API) ZoneDNSSECSetting(zoneID string) (ZoneDNSSEC, error) {
+	return api.ZoneDNSSECSettingContext(context.TODO(), zoneID)
+}
+
+// ZoneDNSSECSettingContext is like ZoneDNSSECSetting but takes a context.
+func (api *API) ZoneDNSSECSettingContext(ctx context.Context, zoneID string) (ZoneDNSSEC, error) {
+	uri := "/zones/" + zoneID + "/dnssec"
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return ZoneDNSSEC{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneDNSSECResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ZoneDNSSEC{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateZoneDNSSEC enables or disables DNSSEC for a zone. Enabling it
+// leaves the zone pending until the DS record is added to the parent zone.
+//
+// API reference: https://api.cloudflare.com/#dnssec-edit-dnssec-status
+func (api *API) UpdateZoneDNSSEC(zoneID string, options ZoneDNSSECUpdateOptions) (ZoneDNSSEC, error) {
+	return api.UpdateZoneDNSSECContext(context.TODO(), zoneID, options)
+}
+
+// UpdateZoneDNSSECContext is like UpdateZoneDNSSEC but takes a context.
+func (api *API) UpdateZoneDNSSECContext(ctx context.Context, zoneID string, options ZoneDNSSECUpdateOptions) (ZoneDNSSEC, error) {
+	uri := "/zones/" + zoneID + "/dnssec"
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, options)
+	if err != nil {
+		return ZoneDNSSEC{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneDNSSECResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ZoneDNSSEC{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dnssecJSON = `{
+	"status": "active",
+	"flags": 257,
+	"algorithm": "13",
+	"key_type": "ECDSAP256SHA256",
+	"digest_type": "2",
+	"digest_algorithm": "SHA256",
+	"digest": "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+	"ds": "example.com. 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+	"key_tag": 42,
+	"public_key": "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+	"modified_on": "2014-01-01T05:20:00Z"
+}`
+
+var expectedZoneDNSSEC = ZoneDNSSEC{
+	Status:          "active",
+	Flags:           257,
+	Algorithm:       "13",
+	KeyType:         "ECDSAP256SHA256",
+	DigestType:      "2",
+	DigestAlgorithm: "SHA256",
+	Digest:          "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+	DS:              "example.com. 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+	KeyTag:          42,
+	PublicKey:       "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+	ModifiedOn:      time.Date(2014, 1, 1, 5, 20, 0, 0, time.UTC),
+}
+
+func TestZoneDNSSECSetting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dnssec", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, dnssecJSON)
+	})
+
+	actual, err := client.ZoneDNSSECSetting("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedZoneDNSSEC, actual)
+	}
+}
+
+func TestUpdateZoneDNSSEC(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dnssec", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"status": "disabled"}`, string(b))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"status": "pending-disabled", "modified_on": "2014-01-01T05:20:00Z"}}`)
+	})
+
+	actual, err := client.UpdateZoneDNSSEC("023e105f4ecef8ad9ca31a8372d0c353", ZoneDNSSECUpdateOptions{Status: "disabled"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "pending-disabled", actual.Status)
+	}
+}
+
+func TestZoneDNSSEC_DSRecord(t *testing.T) {
+	rr, err := expectedZoneDNSSEC.DSRecord()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "DS", rr.Type)
+	assert.Equal(t, "example.com", rr.Name)
+	assert.Equal(t, 3600, rr.TTL)
+	assert.Equal(t, DSRecordData{
+		KeyTag:     16953,
+		Algorithm:  13,
+		DigestType: 2,
+		Digest:     "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+	}, rr.Data)
+
+	// The data survives a trip through the API.
+	b, _ := json.Marshal(rr)
+	var decoded DNSRecord
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	var ds DSRecordData
+	if assert.NoError(t, decoded.DecodeData(&ds)) {
+		assert.Equal(t, rr.Data, ds)
+	}
+
+	_, err = ZoneDNSSEC{Status: "disabled"}.DSRecord()
+	assert.Error(t, err)
+	_, err = ZoneDNSSEC{DS: "example.com. 3600 IN DS 16953 13 2 XYZ"}.DSRecord()
+	assert.Error(t, err)
+	_, err = ZoneDNSSEC{DS: "example.com. 3600 IN DNSKEY 257 3 13 AAAA"}.DSRecord()
+	assert.Error(t, err)
+}
+
+func TestZoneDNSSEC_DNSKEY(t *testing.T) {
+	key, err := expectedZoneDNSSEC.DNSKEY()
+	if assert.NoError(t, err) {
+		assert.Equal(t, DNSKEYRecordData{
+			Flags:     257,
+			Protocol:  3,
+			Algorithm: 13,
+			PublicKey: expectedZoneDNSSEC.PublicKey,
+		}, key)
+	}
+
+	_, err = ZoneDNSSEC{Status: "disabled"}.DNSKEY()
+	assert.Error(t, err)
+}
@@ -1,15 +1,28 @@
 package cloudflare
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
 // Error messages
 const (
 	errEmptyCredentials     = "invalid credentials: key & email must not be empty"
 	errEmptyAPIToken        = "invalid credentials: API Token must not be empty"
+	errNilProvider          = "invalid credentials: credential provider must not be nil"
 	errMakeRequestError     = "error from makeRequest"
 	errUnmarshalError       = "error unmarshalling the JSON response"
 	errRequestNotSuccessful = "error reported by API"
 	errMissingAccountID     = "account ID is empty and must be provided"
 )
 
+// API error codes
+const (
+	errCodeRecordAlreadyExists = 81057
+)
+
 var _ Error = &UserError{}
 
 // Error represents an error returned from this library.
@@ -48,3 +61,154 @@ func (e *UserError) Parse() bool {
 func (e *UserError) Error() string {
 	return e.Err.Error()
 }
+
+// APIError is returned when the Cloudflare API responds with a non-2xx
+// status code. It is wrapped by the resource methods, so callers should use
+// errors.Cause, errors.As on Go 1.13 and later, or the IsNotFound,
+// IsRateLimited, IsAuth and IsConflict helpers rather than inspecting the
+// error string.
+type APIError struct {
+	// StatusCode is the HTTP status code of the final response.
+	StatusCode int
+	// Method and URI identify the request that failed. URI is relative to
+	// the client's BaseURL.
+	Method string
+	URI    string
+	// RayID is the value of the CF-Ray response header, if present. It
+	// should be included when contacting Cloudflare support.
+	RayID string
+	// Retried reports whether the request was attempted more than once
+	// before this response was received.
+	Retried bool
+	// Errors and Messages are decoded from the response body, if it was a
+	// valid API response.
+	Errors   []ResponseInfo
+	Messages []ResponseInfo
+
+	body []byte
+}
+
+var _ Error = &APIError{}
+
+// newAPIError builds an *APIError from a completed HTTP response and its
+// already-read body.
+func newAPIError(method, uri string, resp *http.Response, body []byte, retried bool) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		URI:        uri,
+		RayID:      resp.Header.Get("CF-Ray"),
+		Retried:    retried,
+		body:       body,
+	}
+
+	var r Response
+	if err := json.Unmarshal(body, &r); err == nil {
+		e.Errors = r.Errors
+		e.Messages = r.Messages
+	}
+
+	return e
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var reason string
+	switch {
+	case len(e.Errors) > 0:
+		msgs := make([]string, 0, len(e.Errors))
+		for _, info := range e.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s (%d)", info.Message, info.Code))
+		}
+		reason = strings.Join(msgs, ", ")
+	case e.StatusCode == http.StatusUnauthorized:
+		reason = "invalid credentials"
+	case e.StatusCode == http.StatusForbidden:
+		reason = "insufficient permissions"
+	case e.StatusCode >= http.StatusInternalServerError:
+		reason = "service failure"
+	default:
+		reason = fmt.Sprintf("content %q", e.body)
+	}
+
+	s := fmt.Sprintf("HTTP status %d: %s", e.StatusCode, reason)
+	if e.RayID != "" {
+		s += " (ray ID " + e.RayID + ")"
+	}
+	return s
+}
+
+// User is true for client errors (4xx) other than rate limiting.
+func (e *APIError) User() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500 && e.StatusCode != http.StatusTooManyRequests
+}
+
+// Parse is always false; the response was received and understood.
+func (e *APIError) Parse() bool {
+	return false
+}
+
+// Network is true for server-side failures (5xx) and rate limiting, which
+// are generally transient.
+func (e *APIError) Network() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
+
+// HasErrorCode reports whether the API returned the given error code.
+func (e *APIError) HasErrorCode(code int) bool {
+	for _, info := range e.Errors {
+		if info.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// apiErrorFrom extracts an *APIError from err, if it contains one. It
+// follows both Cause and Unwrap, rather than using errors.As, so that it
+// works before Go 1.13.
+func apiErrorFrom(err error) (*APIError, bool) {
+	for err != nil {
+		if apiErr, ok := err.(*APIError); ok {
+			return apiErr, true
+		}
+		switch e := err.(type) {
+		case interface{ Cause() error }:
+			err = e.Cause()
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err was caused by the API responding with
+// HTTP 404.
+func IsNotFound(err error) bool {
+	apiErr, ok := apiErrorFrom(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err was caused by the API responding with
+// HTTP 429.
+func IsRateLimited(err error) bool {
+	apiErr, ok := apiErrorFrom(err)
+	return ok && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsAuth reports whether err was caused by invalid credentials (HTTP 401)
+// or insufficient permissions (HTTP 403).
+func IsAuth(err error) bool {
+	apiErr, ok := apiErrorFrom(err)
+	return ok && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsConflict reports whether err was caused by the resource already
+// existing. This is HTTP 409, or the "record already exists" error code the
+// DNS endpoints return with HTTP 400.
+func IsConflict(err error) bool {
+	apiErr, ok := apiErrorFrom(err)
+	return ok && (apiErr.StatusCode == http.StatusConflict || apiErr.HasErrorCode(errCodeRecordAlreadyExists))
+}
@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET", "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("CF-Ray", "4a1b2c3d4e5f6a7b-SJC")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 81044, "message": "Record does not exist."}],
+			"messages": [],
+			"result": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records/372e67954025e0ba6aaa6d586b9e0b59", handler)
+
+	_, err := client.DNSRecord("023e105f4ecef8ad9ca31a8372d0c353", "372e67954025e0ba6aaa6d586b9e0b59")
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+	assert.False(t, IsAuth(err))
+	assert.False(t, IsConflict(err))
+
+	if apiErr, ok := apiErrorFrom(err); assert.True(t, ok) {
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "GET", apiErr.Method)
+		assert.Equal(t, "/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records/372e67954025e0ba6aaa6d586b9e0b59", apiErr.URI)
+		assert.Equal(t, "4a1b2c3d4e5f6a7b-SJC", apiErr.RayID)
+		assert.False(t, apiErr.Retried)
+		assert.Equal(t, []ResponseInfo{{Code: 81044, Message: "Record does not exist."}}, apiErr.Errors)
+		assert.True(t, apiErr.User())
+		assert.False(t, apiErr.Network())
+		assert.Equal(t, "HTTP status 404: Record does not exist. (81044) (ray ID 4a1b2c3d4e5f6a7b-SJC)", apiErr.Error())
+	}
+}
+
+func TestAPIError_Conflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST", "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 81057, "message": "The record already exists."}],
+			"messages": [],
+			"result": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", handler)
+
+	_, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "A", Name: "example.com", Content: "198.51.100.4"})
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsNotFound(err))
+}
+
+func TestAPIError_Auth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}
+
+	mux.HandleFunc("/user", handler)
+
+	_, err := client.UserDetails()
+	assert.True(t, IsAuth(err))
+	assert.Contains(t, err.Error(), "HTTP status 403: insufficient permissions")
+}
+
+func TestAPIError_RateLimitedAfterRetries(t *testing.T) {
+	setup(UsingRetryPolicy(1, 0, 0))
+	defer teardown()
+
+	requestsReceived := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 10000, "message": "Rate limited."}],
+			"messages": [],
+			"result": null
+		}`)
+	}
+
+	mux.HandleFunc("/user", handler)
+
+	_, err := client.UserDetails()
+	assert.Equal(t, 2, requestsReceived)
+	assert.True(t, IsRateLimited(err))
+
+	if apiErr, ok := apiErrorFrom(err); assert.True(t, ok) {
+		assert.True(t, apiErr.Retried)
+		assert.True(t, apiErr.Network())
+		assert.False(t, apiErr.User())
+	}
+}
+
+func TestAPIError_ClassifiersIgnoreOtherErrors(t *testing.T) {
+	err := errors.New("something else")
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+	assert.False(t, IsAuth(err))
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsNotFound(nil))
+}
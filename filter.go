@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -62,9 +63,14 @@ type FilterValidationExpressionMessage struct {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-by-filter-id
 func (api *API) Filter(zoneID, filterID string) (Filter, error) {
+	return api.FilterContext(context.TODO(), zoneID, filterID)
+}
+
+// FilterContext is like Filter but takes a context.
+func (api *API) FilterContext(ctx context.Context, zoneID, filterID string) (Filter, error) {
 	uri := fmt.Sprintf("/zones/%s/filters/%s", zoneID, filterID)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return Filter{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -82,6 +88,17 @@ func (api *API) Filter(zoneID, filterID string) (Filter, error) {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-all-filters
 func (api *API) Filters(zoneID string, pageOpts PaginationOptions) ([]Filter, error) {
+	return api.FiltersContext(context.TODO(), zoneID, pageOpts)
+}
+
+// FiltersContext is like Filters but takes a context.
+func (api *API) FiltersContext(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]Filter, error) {
+	filters, _, err := api.filtersPage(ctx, zoneID, pageOpts)
+	return filters, err
+}
+
+// filtersPage fetches a single page of filters.
+func (api *API) filtersPage(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]Filter, ResultInfo, error) {
 	uri := "/zones/" + zoneID + "/filters"
 	v := url.Values{}
 
@@ -97,27 +114,60 @@ func (api *API) Filters(zoneID string, pageOpts PaginationOptions) ([]Filter, er
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return []Filter{}, errors.Wrap(err, errMakeRequestError)
+		return []Filter{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var filtersResponse FiltersDetailResponse
 	err = json.Unmarshal(res, &filtersResponse)
 	if err != nil {
-		return []Filter{}, errors.Wrap(err, errUnmarshalError)
+		return []Filter{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return filtersResponse.Result, nil
+	return filtersResponse.Result, filtersResponse.ResultInfo, nil
+}
+
+// FilterIterator iterates over filters. See Paginator.
+type FilterIterator struct {
+	*Paginator
+}
+
+// Value returns the current filter.
+func (it *FilterIterator) Value() Filter {
+	v, _ := it.value().(Filter)
+	return v
+}
+
+// All returns every remaining filter, fetching up to concurrency pages at a
+// time.
+func (it *FilterIterator) All(concurrency int) ([]Filter, error) {
+	var filters []Filter
+	if err := it.collect(concurrency, &filters); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// FiltersIter returns an iterator over the filters of a zone.
+func (api *API) FiltersIter(ctx context.Context, zoneID string, pageOpts PaginationOptions) *FilterIterator {
+	return &FilterIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.filtersPage(ctx, zoneID, pageOpts)
+	})}
 }
 
 // CreateFilters creates new filters.
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/post/
 func (api *API) CreateFilters(zoneID string, filters []Filter) ([]Filter, error) {
+	return api.CreateFiltersContext(context.TODO(), zoneID, filters)
+}
+
+// CreateFiltersContext is like CreateFilters but takes a context.
+func (api *API) CreateFiltersContext(ctx context.Context, zoneID string, filters []Filter) ([]Filter, error) {
 	uri := "/zones/" + zoneID + "/filters"
 
-	res, err := api.makeRequest("POST", uri, filters)
+	res, err := api.makeRequestContext(ctx, "POST", uri, filters)
 	if err != nil {
 		return []Filter{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -135,13 +185,18 @@ func (api *API) CreateFilters(zoneID string, filters []Filter) ([]Filter, error)
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/put/#update-a-single-filter
 func (api *API) UpdateFilter(zoneID string, filter Filter) (Filter, error) {
+	return api.UpdateFilterContext(context.TODO(), zoneID, filter)
+}
+
+// UpdateFilterContext is like UpdateFilter but takes a context.
+func (api *API) UpdateFilterContext(ctx context.Context, zoneID string, filter Filter) (Filter, error) {
 	if filter.ID == "" {
 		return Filter{}, errors.Errorf("filter ID cannot be empty")
 	}
 
 	uri := fmt.Sprintf("/zones/%s/filters/%s", zoneID, filter.ID)
 
-	res, err := api.makeRequest("PUT", uri, filter)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, filter)
 	if err != nil {
 		return Filter{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -159,6 +214,11 @@ func (api *API) UpdateFilter(zoneID string, filter Filter) (Filter, error) {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/put/#update-multiple-filters
 func (api *API) UpdateFilters(zoneID string, filters []Filter) ([]Filter, error) {
+	return api.UpdateFiltersContext(context.TODO(), zoneID, filters)
+}
+
+// UpdateFiltersContext is like UpdateFilters but takes a context.
+func (api *API) UpdateFiltersContext(ctx context.Context, zoneID string, filters []Filter) ([]Filter, error) {
 	for _, filter := range filters {
 		if filter.ID == "" {
 			return []Filter{}, errors.Errorf("filter ID cannot be empty")
@@ -167,7 +227,7 @@ func (api *API) UpdateFilters(zoneID string, filters []Filter) ([]Filter, error)
 
 	uri := "/zones/" + zoneID + "/filters"
 
-	res, err := api.makeRequest("PUT", uri, filters)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, filters)
 	if err != nil {
 		return []Filter{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -185,13 +245,18 @@ func (api *API) UpdateFilters(zoneID string, filters []Filter) ([]Filter, error)
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/delete/#delete-a-single-filter
 func (api *API) DeleteFilter(zoneID, filterID string) error {
+	return api.DeleteFilterContext(context.TODO(), zoneID, filterID)
+}
+
+// DeleteFilterContext is like DeleteFilter but takes a context.
+func (api *API) DeleteFilterContext(ctx context.Context, zoneID, filterID string) error {
 	if filterID == "" {
 		return errors.Errorf("filter ID cannot be empty")
 	}
 
 	uri := fmt.Sprintf("/zones/%s/filters/%s", zoneID, filterID)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -203,10 +268,15 @@ func (api *API) DeleteFilter(zoneID, filterID string) error {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/delete/#delete-multiple-filters
 func (api *API) DeleteFilters(zoneID string, filterIDs []string) error {
+	return api.DeleteFiltersContext(context.TODO(), zoneID, filterIDs)
+}
+
+// DeleteFiltersContext is like DeleteFilters but takes a context.
+func (api *API) DeleteFiltersContext(ctx context.Context, zoneID string, filterIDs []string) error {
 	ids := strings.Join(filterIDs, ",")
 	uri := fmt.Sprintf("/zones/%s/filters?id=%s", zoneID, ids)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -218,23 +288,24 @@ func (api *API) DeleteFilters(zoneID string, filterIDs []string) error {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/validation/
 func (api *API) ValidateFilterExpression(expression string) error {
+	return api.ValidateFilterExpressionContext(context.TODO(), expression)
+}
+
+// ValidateFilterExpressionContext is like ValidateFilterExpression but takes a context.
+func (api *API) ValidateFilterExpressionContext(ctx context.Context, expression string) error {
 	uri := fmt.Sprintf("/filters/validate-expr")
 	expressionPayload := FilterValidateExpression{Expression: expression}
 
-	_, err := api.makeRequest("POST", uri, expressionPayload)
+	_, err := api.makeRequestContext(ctx, "POST", uri, expressionPayload)
 	if err != nil {
-		var filterValidationResponse FilterValidateExpressionResponse
-
-		jsonErr := json.Unmarshal([]byte(err.Error()), &filterValidationResponse)
-		if jsonErr != nil {
-			return errors.Wrap(jsonErr, errUnmarshalError)
+		apiErr, ok := apiErrorFrom(err)
+		if !ok || len(apiErr.Errors) == 0 {
+			return errors.Wrap(err, errMakeRequestError)
 		}
 
-		if filterValidationResponse.Success != true {
-			// Unsure why but the API returns `errors` as an array but it only
-			// ever shows the issue with one problem at a time ¯\_(ツ)_/¯
-			return errors.Errorf(filterValidationResponse.Errors[0].Message)
-		}
+		// Unsure why but the API returns `errors` as an array but it only
+		// ever shows the issue with one problem at a time ¯\_(ツ)_/¯
+		return errors.New(apiErr.Errors[0].Message)
 	}
 
 	return nil
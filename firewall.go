@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -58,21 +59,43 @@ type AccessRuleListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-list-access-rules
 func (api *API) ListUserAccessRules(accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
-	return api.listAccessRules("/user", accessRule, page)
+	return api.ListUserAccessRulesContext(context.TODO(), accessRule, page)
+}
+
+// ListUserAccessRulesContext is like ListUserAccessRules but takes a context.
+func (api *API) ListUserAccessRulesContext(ctx context.Context, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
+	return api.listAccessRules(ctx, "/user", accessRule, page)
+}
+
+// ListUserAccessRulesIter returns an iterator over the access rules for the
+// logged-in user,
+// filtered by accessRule in the same way as ListUserAccessRules.
+func (api *API) ListUserAccessRulesIter(ctx context.Context, accessRule AccessRule) *AccessRuleIterator {
+	return api.accessRulesIter(ctx, "/user", accessRule)
 }
 
 // CreateUserAccessRule creates a firewall access rule for the logged-in user.
 //
 // API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-create-access-rule
 func (api *API) CreateUserAccessRule(accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.createAccessRule("/user", accessRule)
+	return api.CreateUserAccessRuleContext(context.TODO(), accessRule)
+}
+
+// CreateUserAccessRuleContext is like CreateUserAccessRule but takes a context.
+func (api *API) CreateUserAccessRuleContext(ctx context.Context, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.createAccessRule(ctx, "/user", accessRule)
 }
 
 // UserAccessRule returns the details of a user's account access rule.
 //
 // API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-list-access-rules
 func (api *API) UserAccessRule(accessRuleID string) (*AccessRuleResponse, error) {
-	return api.retrieveAccessRule("/user", accessRuleID)
+	return api.UserAccessRuleContext(context.TODO(), accessRuleID)
+}
+
+// UserAccessRuleContext is like UserAccessRule but takes a context.
+func (api *API) UserAccessRuleContext(ctx context.Context, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.retrieveAccessRule(ctx, "/user", accessRuleID)
 }
 
 // UpdateUserAccessRule updates a single access rule for the logged-in user &
@@ -80,7 +103,12 @@ func (api *API) UserAccessRule(accessRuleID string) (*AccessRuleResponse, error)
 //
 // API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-update-access-rule
 func (api *API) UpdateUserAccessRule(accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.updateAccessRule("/user", accessRuleID, accessRule)
+	return api.UpdateUserAccessRuleContext(context.TODO(), accessRuleID, accessRule)
+}
+
+// UpdateUserAccessRuleContext is like UpdateUserAccessRule but takes a context.
+func (api *API) UpdateUserAccessRuleContext(ctx context.Context, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.updateAccessRule(ctx, "/user", accessRuleID, accessRule)
 }
 
 // DeleteUserAccessRule deletes a single access rule for the logged-in user and
@@ -88,7 +116,12 @@ func (api *API) UpdateUserAccessRule(accessRuleID string, accessRule AccessRule)
 //
 // API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-update-access-rule
 func (api *API) DeleteUserAccessRule(accessRuleID string) (*AccessRuleResponse, error) {
-	return api.deleteAccessRule("/user", accessRuleID)
+	return api.DeleteUserAccessRuleContext(context.TODO(), accessRuleID)
+}
+
+// DeleteUserAccessRuleContext is like DeleteUserAccessRule but takes a context.
+func (api *API) DeleteUserAccessRuleContext(ctx context.Context, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.deleteAccessRule(ctx, "/user", accessRuleID)
 }
 
 // ListZoneAccessRules returns a slice of access rules for the given zone
@@ -98,7 +131,19 @@ func (api *API) DeleteUserAccessRule(accessRuleID string) (*AccessRuleResponse,
 //
 // API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-list-access-rules
 func (api *API) ListZoneAccessRules(zoneID string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
-	return api.listAccessRules("/zones/"+zoneID, accessRule, page)
+	return api.ListZoneAccessRulesContext(context.TODO(), zoneID, accessRule, page)
+}
+
+// ListZoneAccessRulesContext is like ListZoneAccessRules but takes a context.
+func (api *API) ListZoneAccessRulesContext(ctx context.Context, zoneID string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
+	return api.listAccessRules(ctx, "/zones/"+zoneID, accessRule, page)
+}
+
+// ListZoneAccessRulesIter returns an iterator over the access rules for the
+// given zone identifier,
+// filtered by accessRule in the same way as ListZoneAccessRules.
+func (api *API) ListZoneAccessRulesIter(ctx context.Context, zoneID string, accessRule AccessRule) *AccessRuleIterator {
+	return api.accessRulesIter(ctx, "/zones/"+zoneID, accessRule)
 }
 
 // CreateZoneAccessRule creates a firewall access rule for the given zone
@@ -106,14 +151,24 @@ func (api *API) ListZoneAccessRules(zoneID string, accessRule AccessRule, page i
 //
 // API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-create-access-rule
 func (api *API) CreateZoneAccessRule(zoneID string, accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.createAccessRule("/zones/"+zoneID, accessRule)
+	return api.CreateZoneAccessRuleContext(context.TODO(), zoneID, accessRule)
+}
+
+// CreateZoneAccessRuleContext is like CreateZoneAccessRule but takes a context.
+func (api *API) CreateZoneAccessRuleContext(ctx context.Context, zoneID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.createAccessRule(ctx, "/zones/"+zoneID, accessRule)
 }
 
 // ZoneAccessRule returns the details of a zone's access rule.
 //
 // API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-list-access-rules
 func (api *API) ZoneAccessRule(zoneID string, accessRuleID string) (*AccessRuleResponse, error) {
-	return api.retrieveAccessRule("/zones/"+zoneID, accessRuleID)
+	return api.ZoneAccessRuleContext(context.TODO(), zoneID, accessRuleID)
+}
+
+// ZoneAccessRuleContext is like ZoneAccessRule but takes a context.
+func (api *API) ZoneAccessRuleContext(ctx context.Context, zoneID string, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.retrieveAccessRule(ctx, "/zones/"+zoneID, accessRuleID)
 }
 
 // UpdateZoneAccessRule updates a single access rule for the given zone &
@@ -121,7 +176,12 @@ func (api *API) ZoneAccessRule(zoneID string, accessRuleID string) (*AccessRuleR
 //
 // API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-update-access-rule
 func (api *API) UpdateZoneAccessRule(zoneID, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.updateAccessRule("/zones/"+zoneID, accessRuleID, accessRule)
+	return api.UpdateZoneAccessRuleContext(context.TODO(), zoneID, accessRuleID, accessRule)
+}
+
+// UpdateZoneAccessRuleContext is like UpdateZoneAccessRule but takes a context.
+func (api *API) UpdateZoneAccessRuleContext(ctx context.Context, zoneID, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.updateAccessRule(ctx, "/zones/"+zoneID, accessRuleID, accessRule)
 }
 
 // DeleteZoneAccessRule deletes a single access rule for the given zone and
@@ -129,7 +189,12 @@ func (api *API) UpdateZoneAccessRule(zoneID, accessRuleID string, accessRule Acc
 //
 // API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-delete-access-rule
 func (api *API) DeleteZoneAccessRule(zoneID, accessRuleID string) (*AccessRuleResponse, error) {
-	return api.deleteAccessRule("/zones/"+zoneID, accessRuleID)
+	return api.DeleteZoneAccessRuleContext(context.TODO(), zoneID, accessRuleID)
+}
+
+// DeleteZoneAccessRuleContext is like DeleteZoneAccessRule but takes a context.
+func (api *API) DeleteZoneAccessRuleContext(ctx context.Context, zoneID, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.deleteAccessRule(ctx, "/zones/"+zoneID, accessRuleID)
 }
 
 // ListAccountAccessRules returns a slice of access rules for the given
@@ -139,7 +204,19 @@ func (api *API) DeleteZoneAccessRule(zoneID, accessRuleID string) (*AccessRuleRe
 //
 // API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-list-access-rules
 func (api *API) ListAccountAccessRules(accountID string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
-	return api.listAccessRules("/accounts/"+accountID, accessRule, page)
+	return api.ListAccountAccessRulesContext(context.TODO(), accountID, accessRule, page)
+}
+
+// ListAccountAccessRulesContext is like ListAccountAccessRules but takes a context.
+func (api *API) ListAccountAccessRulesContext(ctx context.Context, accountID string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
+	return api.listAccessRules(ctx, "/accounts/"+accountID, accessRule, page)
+}
+
+// ListAccountAccessRulesIter returns an iterator over the access rules for the
+// given account identifier,
+// filtered by accessRule in the same way as ListAccountAccessRules.
+func (api *API) ListAccountAccessRulesIter(ctx context.Context, accountID string, accessRule AccessRule) *AccessRuleIterator {
+	return api.accessRulesIter(ctx, "/accounts/"+accountID, accessRule)
 }
 
 // CreateAccountAccessRule creates a firewall access rule for the given
@@ -147,14 +224,24 @@ func (api *API) ListAccountAccessRules(accountID string, accessRule AccessRule,
 //
 // API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-create-access-rule
 func (api *API) CreateAccountAccessRule(accountID string, accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.createAccessRule("/accounts/"+accountID, accessRule)
+	return api.CreateAccountAccessRuleContext(context.TODO(), accountID, accessRule)
+}
+
+// CreateAccountAccessRuleContext is like CreateAccountAccessRule but takes a context.
+func (api *API) CreateAccountAccessRuleContext(ctx context.Context, accountID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.createAccessRule(ctx, "/accounts/"+accountID, accessRule)
 }
 
 // AccountAccessRule returns the details of an account's access rule.
 //
 // API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-access-rule-details
 func (api *API) AccountAccessRule(accountID string, accessRuleID string) (*AccessRuleResponse, error) {
-	return api.retrieveAccessRule("/accounts/"+accountID, accessRuleID)
+	return api.AccountAccessRuleContext(context.TODO(), accountID, accessRuleID)
+}
+
+// AccountAccessRuleContext is like AccountAccessRule but takes a context.
+func (api *API) AccountAccessRuleContext(ctx context.Context, accountID string, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.retrieveAccessRule(ctx, "/accounts/"+accountID, accessRuleID)
 }
 
 // UpdateAccountAccessRule updates a single access rule for the given
@@ -162,7 +249,12 @@ func (api *API) AccountAccessRule(accountID string, accessRuleID string) (*Acces
 //
 // API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-update-access-rule
 func (api *API) UpdateAccountAccessRule(accountID, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
-	return api.updateAccessRule("/accounts/"+accountID, accessRuleID, accessRule)
+	return api.UpdateAccountAccessRuleContext(context.TODO(), accountID, accessRuleID, accessRule)
+}
+
+// UpdateAccountAccessRuleContext is like UpdateAccountAccessRule but takes a context.
+func (api *API) UpdateAccountAccessRuleContext(ctx context.Context, accountID, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+	return api.updateAccessRule(ctx, "/accounts/"+accountID, accessRuleID, accessRule)
 }
 
 // DeleteAccountAccessRule deletes a single access rule for the given
@@ -170,10 +262,47 @@ func (api *API) UpdateAccountAccessRule(accountID, accessRuleID string, accessRu
 //
 // API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-delete-access-rule
 func (api *API) DeleteAccountAccessRule(accountID, accessRuleID string) (*AccessRuleResponse, error) {
-	return api.deleteAccessRule("/accounts/"+accountID, accessRuleID)
+	return api.DeleteAccountAccessRuleContext(context.TODO(), accountID, accessRuleID)
+}
+
+// DeleteAccountAccessRuleContext is like DeleteAccountAccessRule but takes a context.
+func (api *API) DeleteAccountAccessRuleContext(ctx context.Context, accountID, accessRuleID string) (*AccessRuleResponse, error) {
+	return api.deleteAccessRule(ctx, "/accounts/"+accountID, accessRuleID)
+}
+
+// AccessRuleIterator iterates over access rules. See Paginator.
+type AccessRuleIterator struct {
+	*Paginator
+}
+
+// Value returns the current access rule.
+func (it *AccessRuleIterator) Value() AccessRule {
+	v, _ := it.value().(AccessRule)
+	return v
+}
+
+// All returns every remaining access rule, fetching up to concurrency pages at
+// a time.
+func (it *AccessRuleIterator) All(concurrency int) ([]AccessRule, error) {
+	var rules []AccessRule
+	if err := it.collect(concurrency, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// accessRulesIter returns an iterator over the access rules under prefix.
+func (api *API) accessRulesIter(ctx context.Context, prefix string, accessRule AccessRule) *AccessRuleIterator {
+	return &AccessRuleIterator{newPaginator(ctx, PaginationOptions{}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		r, err := api.listAccessRules(ctx, prefix, accessRule, pageOpts.Page)
+		if err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return r.Result, r.ResultInfo, nil
+	})}
 }
 
-func (api *API) listAccessRules(prefix string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
+func (api *API) listAccessRules(ctx context.Context, prefix string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
 	// Construct a query string
 	v := url.Values{}
 	if page <= 0 {
@@ -201,7 +330,7 @@ func (api *API) listAccessRules(prefix string, accessRule AccessRule, page int)
 	query := "?" + v.Encode()
 
 	uri := prefix + "/firewall/access_rules/rules" + query
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -214,9 +343,9 @@ func (api *API) listAccessRules(prefix string, accessRule AccessRule, page int)
 	return response, nil
 }
 
-func (api *API) createAccessRule(prefix string, accessRule AccessRule) (*AccessRuleResponse, error) {
+func (api *API) createAccessRule(ctx context.Context, prefix string, accessRule AccessRule) (*AccessRuleResponse, error) {
 	uri := prefix + "/firewall/access_rules/rules"
-	res, err := api.makeRequest("POST", uri, accessRule)
+	res, err := api.makeRequestContext(ctx, "POST", uri, accessRule)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -230,10 +359,10 @@ func (api *API) createAccessRule(prefix string, accessRule AccessRule) (*AccessR
 	return response, nil
 }
 
-func (api *API) retrieveAccessRule(prefix, accessRuleID string) (*AccessRuleResponse, error) {
+func (api *API) retrieveAccessRule(ctx context.Context, prefix, accessRuleID string) (*AccessRuleResponse, error) {
 	uri := prefix + "/firewall/access_rules/rules/" + accessRuleID
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -248,9 +377,9 @@ func (api *API) retrieveAccessRule(prefix, accessRuleID string) (*AccessRuleResp
 	return response, nil
 }
 
-func (api *API) updateAccessRule(prefix, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
+func (api *API) updateAccessRule(ctx context.Context, prefix, accessRuleID string, accessRule AccessRule) (*AccessRuleResponse, error) {
 	uri := prefix + "/firewall/access_rules/rules/" + accessRuleID
-	res, err := api.makeRequest("PATCH", uri, accessRule)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, accessRule)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -263,9 +392,9 @@ func (api *API) updateAccessRule(prefix, accessRuleID string, accessRule AccessR
 	return response, nil
 }
 
-func (api *API) deleteAccessRule(prefix, accessRuleID string) (*AccessRuleResponse, error) {
+func (api *API) deleteAccessRule(ctx context.Context, prefix, accessRuleID string) (*AccessRuleResponse, error) {
 	uri := prefix + "/firewall/access_rules/rules/" + accessRuleID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
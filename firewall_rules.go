@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -43,6 +44,17 @@ type FirewallRuleResponse struct {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-all-rules
 func (api *API) FirewallRules(zoneID string, pageOpts PaginationOptions) ([]FirewallRule, error) {
+	return api.FirewallRulesContext(context.TODO(), zoneID, pageOpts)
+}
+
+// FirewallRulesContext is like FirewallRules but takes a context.
+func (api *API) FirewallRulesContext(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]FirewallRule, error) {
+	rules, _, err := api.firewallRulesPage(ctx, zoneID, pageOpts)
+	return rules, err
+}
+
+// firewallRulesPage fetches a single page of firewall rules.
+func (api *API) firewallRulesPage(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]FirewallRule, ResultInfo, error) {
 	uri := fmt.Sprintf("/zones/%s/firewall/rules", zoneID)
 	v := url.Values{}
 
@@ -58,27 +70,60 @@ func (api *API) FirewallRules(zoneID string, pageOpts PaginationOptions) ([]Fire
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return []FirewallRule{}, errors.Wrap(err, errMakeRequestError)
+		return []FirewallRule{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var firewallDetailResponse FirewallRulesDetailResponse
 	err = json.Unmarshal(res, &firewallDetailResponse)
 	if err != nil {
-		return []FirewallRule{}, errors.Wrap(err, errUnmarshalError)
+		return []FirewallRule{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return firewallDetailResponse.Result, nil
+	return firewallDetailResponse.Result, firewallDetailResponse.ResultInfo, nil
+}
+
+// FirewallRuleIterator iterates over firewall rules. See Paginator.
+type FirewallRuleIterator struct {
+	*Paginator
+}
+
+// Value returns the current firewall rule.
+func (it *FirewallRuleIterator) Value() FirewallRule {
+	v, _ := it.value().(FirewallRule)
+	return v
+}
+
+// All returns every remaining firewall rule, fetching up to concurrency pages
+// at a time.
+func (it *FirewallRuleIterator) All(concurrency int) ([]FirewallRule, error) {
+	var rules []FirewallRule
+	if err := it.collect(concurrency, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// FirewallRulesIter returns an iterator over the firewall rules of a zone.
+func (api *API) FirewallRulesIter(ctx context.Context, zoneID string, pageOpts PaginationOptions) *FirewallRuleIterator {
+	return &FirewallRuleIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.firewallRulesPage(ctx, zoneID, pageOpts)
+	})}
 }
 
 // FirewallRule returns a single firewall rule based on the ID.
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-by-rule-id
 func (api *API) FirewallRule(zoneID, firewallRuleID string) (FirewallRule, error) {
+	return api.FirewallRuleContext(context.TODO(), zoneID, firewallRuleID)
+}
+
+// FirewallRuleContext is like FirewallRule but takes a context.
+func (api *API) FirewallRuleContext(ctx context.Context, zoneID, firewallRuleID string) (FirewallRule, error) {
 	uri := fmt.Sprintf("/zones/%s/firewall/rules/%s", zoneID, firewallRuleID)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return FirewallRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -96,9 +141,14 @@ func (api *API) FirewallRule(zoneID, firewallRuleID string) (FirewallRule, error
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/post/
 func (api *API) CreateFirewallRules(zoneID string, firewallRules []FirewallRule) ([]FirewallRule, error) {
+	return api.CreateFirewallRulesContext(context.TODO(), zoneID, firewallRules)
+}
+
+// CreateFirewallRulesContext is like CreateFirewallRules but takes a context.
+func (api *API) CreateFirewallRulesContext(ctx context.Context, zoneID string, firewallRules []FirewallRule) ([]FirewallRule, error) {
 	uri := fmt.Sprintf("/zones/%s/firewall/rules", zoneID)
 
-	res, err := api.makeRequest("POST", uri, firewallRules)
+	res, err := api.makeRequestContext(ctx, "POST", uri, firewallRules)
 	if err != nil {
 		return []FirewallRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -116,13 +166,18 @@ func (api *API) CreateFirewallRules(zoneID string, firewallRules []FirewallRule)
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/put/#update-a-single-rule
 func (api *API) UpdateFirewallRule(zoneID string, firewallRule FirewallRule) (FirewallRule, error) {
+	return api.UpdateFirewallRuleContext(context.TODO(), zoneID, firewallRule)
+}
+
+// UpdateFirewallRuleContext is like UpdateFirewallRule but takes a context.
+func (api *API) UpdateFirewallRuleContext(ctx context.Context, zoneID string, firewallRule FirewallRule) (FirewallRule, error) {
 	if firewallRule.ID == "" {
 		return FirewallRule{}, errors.Errorf("firewall rule ID cannot be empty")
 	}
 
 	uri := fmt.Sprintf("/zones/%s/firewall/rules/%s", zoneID, firewallRule.ID)
 
-	res, err := api.makeRequest("PUT", uri, firewallRule)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, firewallRule)
 	if err != nil {
 		return FirewallRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -140,6 +195,11 @@ func (api *API) UpdateFirewallRule(zoneID string, firewallRule FirewallRule) (Fi
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/put/#update-multiple-rules
 func (api *API) UpdateFirewallRules(zoneID string, firewallRules []FirewallRule) ([]FirewallRule, error) {
+	return api.UpdateFirewallRulesContext(context.TODO(), zoneID, firewallRules)
+}
+
+// UpdateFirewallRulesContext is like UpdateFirewallRules but takes a context.
+func (api *API) UpdateFirewallRulesContext(ctx context.Context, zoneID string, firewallRules []FirewallRule) ([]FirewallRule, error) {
 	for _, firewallRule := range firewallRules {
 		if firewallRule.ID == "" {
 			return []FirewallRule{}, errors.Errorf("firewall ID cannot be empty")
@@ -148,7 +208,7 @@ func (api *API) UpdateFirewallRules(zoneID string, firewallRules []FirewallRule)
 
 	uri := fmt.Sprintf("/zones/%s/firewall/rules", zoneID)
 
-	res, err := api.makeRequest("PUT", uri, firewallRules)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, firewallRules)
 	if err != nil {
 		return []FirewallRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -166,13 +226,18 @@ func (api *API) UpdateFirewallRules(zoneID string, firewallRules []FirewallRule)
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/delete/#delete-a-single-rule
 func (api *API) DeleteFirewallRule(zoneID, firewallRuleID string) error {
+	return api.DeleteFirewallRuleContext(context.TODO(), zoneID, firewallRuleID)
+}
+
+// DeleteFirewallRuleContext is like DeleteFirewallRule but takes a context.
+func (api *API) DeleteFirewallRuleContext(ctx context.Context, zoneID, firewallRuleID string) error {
 	if firewallRuleID == "" {
 		return errors.Errorf("firewall rule ID cannot be empty")
 	}
 
 	uri := fmt.Sprintf("/zones/%s/firewall/rules/%s", zoneID, firewallRuleID)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -184,10 +249,15 @@ func (api *API) DeleteFirewallRule(zoneID, firewallRuleID string) error {
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/delete/#delete-multiple-rules
 func (api *API) DeleteFirewallRules(zoneID string, firewallRuleIDs []string) error {
+	return api.DeleteFirewallRulesContext(context.TODO(), zoneID, firewallRuleIDs)
+}
+
+// DeleteFirewallRulesContext is like DeleteFirewallRules but takes a context.
+func (api *API) DeleteFirewallRulesContext(ctx context.Context, zoneID string, firewallRuleIDs []string) error {
 	ids := strings.Join(firewallRuleIDs, ",")
 	uri := fmt.Sprintf("/zones/%s/firewall/rules?id=%s", zoneID, ids)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
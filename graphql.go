@@ -0,0 +1,291 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GraphQLRequest is a query to the GraphQL Analytics API.
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// GraphQLError is an error reported by the GraphQL Analytics API for a
+// query that it could not fully answer.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Error implements the error interface.
+func (e GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	path := make([]string, len(e.Path))
+	for i, p := range e.Path {
+		path[i] = fmt.Sprint(p)
+	}
+	return strings.Join(path, ".") + ": " + e.Message
+}
+
+// GraphQLErrors holds every error in a GraphQL Analytics API response.
+type GraphQLErrors []GraphQLError
+
+// Error implements the error interface.
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// graphQLResponse is the body of a GraphQL Analytics API response, which
+// does not follow the envelope of the rest of the API.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQL runs a query against the GraphQL Analytics API and decodes the
+// data it returns into data, which should be a pointer to a struct shaped
+// like the query.
+//
+// If the API reports errors, they are returned as GraphQLErrors, after
+// decoding any partial data it also returned.
+//
+// Queries are retried on server errors and network failures under the
+// client's retry policy, as they do not change anything, despite being
+// POST requests.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GraphQL(req GraphQLRequest, data interface{}) error {
+	return api.GraphQLContext(context.TODO(), req, data)
+}
+
+// GraphQLContext is like GraphQL but takes a context.
+func (api *API) GraphQLContext(ctx context.Context, req GraphQLRequest, data interface{}) error {
+	policy := api.retryPolicy
+	if p, ok := retryPolicyFromContext(ctx); ok {
+		policy = p
+	}
+	if policy.Decider == nil {
+		policy.Decider = DefaultRetryDecider{RetryNonIdempotent: true}
+		ctx = ContextWithRetryPolicy(ctx, policy)
+	}
+
+	res, err := api.makeRequestContext(ctx, "POST", "/graphql", req)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	var r graphQLResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if data != nil && len(r.Data) > 0 && string(r.Data) != "null" {
+		if err := json.Unmarshal(r.Data, data); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+	}
+	if len(r.Errors) > 0 {
+		return r.Errors
+	}
+	return nil
+}
+
+// GraphQLAnalyticsOptions selects the data returned by the typed GraphQL
+// Analytics helpers.
+type GraphQLAnalyticsOptions struct {
+	// Exactly one of ZoneTag and AccountTag, the ID of a zone or account,
+	// must be set.
+	ZoneTag    string
+	AccountTag string
+	Since      time.Time
+	// Until defaults to now.
+	Until time.Time
+	// Limit is the most results to return; the default is 10000, the most
+	// the API allows.
+	Limit int
+	// Filter holds further conditions on the dataset, such as
+	// {"action": "block"}, which are combined with the time range.
+	Filter map[string]interface{}
+}
+
+// graphQLDatasetQuery is the query run by the typed helpers. It is
+// formatted with the scope (zones or accounts), the scope's filter field,
+// the name of the filter's input type, the dataset, and its fields.
+const graphQLDatasetQuery = `query ($tag: string, $filter: %[3]s, $limit: Int!) {
+  viewer {
+    %[1]s(filter: {%[2]s: $tag}) {
+      %[4]s(limit: $limit, filter: $filter, orderBy: [datetime_ASC]) %[5]s
+    }
+  }
+}`
+
+// queryDataset runs graphQLDatasetQuery for dataset, decoding its results
+// into the slice results points to.
+func (api *API) queryDataset(ctx context.Context, dataset, fields string, opts GraphQLAnalyticsOptions, results interface{}) error {
+	scope, field, prefix, tag := "zones", "zoneTag", "Zone", opts.ZoneTag
+	switch {
+	case opts.ZoneTag != "" && opts.AccountTag != "":
+		return errors.New("only one of ZoneTag and AccountTag may be set")
+	case opts.AccountTag != "":
+		scope, field, prefix, tag = "accounts", "accountTag", "Account", opts.AccountTag
+	case opts.ZoneTag == "":
+		return errors.New("one of ZoneTag and AccountTag must be set")
+	}
+	until := opts.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	limit := opts.Limit
+	if limit < 1 {
+		limit = 10000
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq": opts.Since.UTC().Format(time.RFC3339),
+		"datetime_lt":  until.UTC().Format(time.RFC3339),
+	}
+	for k, v := range opts.Filter {
+		filter[k] = v
+	}
+	inputType := prefix + strings.ToUpper(dataset[:1]) + dataset[1:] + "Filter_InputObject"
+
+	req := GraphQLRequest{
+		Query: fmt.Sprintf(graphQLDatasetQuery, scope, field, inputType, dataset, fields),
+		Variables: map[string]interface{}{
+			"tag":    tag,
+			"filter": filter,
+			"limit":  limit,
+		},
+	}
+	var data struct {
+		Viewer map[string][]map[string]json.RawMessage `json:"viewer"`
+	}
+	if err := api.GraphQLContext(ctx, req, &data); err != nil {
+		return err
+	}
+	for _, s := range data.Viewer[scope] {
+		if raw, ok := s[dataset]; ok {
+			return errors.Wrap(json.Unmarshal(raw, results), errUnmarshalError)
+		}
+	}
+	return nil
+}
+
+// HTTPRequests1hGroup is the traffic of a zone or account over an hour.
+type HTTPRequests1hGroup struct {
+	Dimensions struct {
+		Datetime time.Time `json:"datetime"`
+	} `json:"dimensions"`
+	Sum struct {
+		Requests          int64                 `json:"requests"`
+		CachedRequests    int64                 `json:"cachedRequests"`
+		EncryptedRequests int64                 `json:"encryptedRequests"`
+		Bytes             int64                 `json:"bytes"`
+		CachedBytes       int64                 `json:"cachedBytes"`
+		EncryptedBytes    int64                 `json:"encryptedBytes"`
+		Threats           int64                 `json:"threats"`
+		PageViews         int64                 `json:"pageViews"`
+		CountryMap        []HTTPRequestsCountry `json:"countryMap"`
+		ResponseStatusMap []HTTPRequestsStatus  `json:"responseStatusMap"`
+	} `json:"sum"`
+	Uniq struct {
+		Uniques int64 `json:"uniques"`
+	} `json:"uniq"`
+}
+
+// HTTPRequestsCountry is the traffic from one country in an
+// HTTPRequests1hGroup.
+type HTTPRequestsCountry struct {
+	ClientCountryName string `json:"clientCountryName"`
+	Requests          int64  `json:"requests"`
+	Bytes             int64  `json:"bytes"`
+	Threats           int64  `json:"threats"`
+}
+
+// HTTPRequestsStatus is the number of responses with one HTTP status in an
+// HTTPRequests1hGroup.
+type HTTPRequestsStatus struct {
+	EdgeResponseStatus int   `json:"edgeResponseStatus"`
+	Requests           int64 `json:"requests"`
+}
+
+const httpRequests1hGroupsFields = `{
+        dimensions { datetime }
+        sum {
+          requests cachedRequests encryptedRequests
+          bytes cachedBytes encryptedBytes
+          threats pageViews
+          countryMap { clientCountryName requests bytes threats }
+          responseStatusMap { edgeResponseStatus requests }
+        }
+        uniq { uniques }
+      }`
+
+// HTTPRequests1hGroups returns the hourly traffic of a zone or account
+// from the httpRequests1hGroups dataset, in order of time.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) HTTPRequests1hGroups(opts GraphQLAnalyticsOptions) ([]HTTPRequests1hGroup, error) {
+	return api.HTTPRequests1hGroupsContext(context.TODO(), opts)
+}
+
+// HTTPRequests1hGroupsContext is like HTTPRequests1hGroups but takes a
+// context.
+func (api *API) HTTPRequests1hGroupsContext(ctx context.Context, opts GraphQLAnalyticsOptions) ([]HTTPRequests1hGroup, error) {
+	var groups []HTTPRequests1hGroup
+	err := api.queryDataset(ctx, "httpRequests1hGroups", httpRequests1hGroupsFields, opts, &groups)
+	return groups, err
+}
+
+// FirewallEvent is a request that a firewall feature acted on.
+type FirewallEvent struct {
+	Datetime          time.Time `json:"datetime"`
+	Action            string    `json:"action"`
+	Source            string    `json:"source"`
+	RuleID            string    `json:"ruleId"`
+	RayName           string    `json:"rayName"`
+	ClientIP          string    `json:"clientIP"`
+	ClientASN         string    `json:"clientAsn"`
+	ClientCountryName string    `json:"clientCountryName"`
+	Host              string    `json:"clientRequestHTTPHost"`
+	Method            string    `json:"clientRequestHTTPMethodName"`
+	Path              string    `json:"clientRequestPath"`
+	Query             string    `json:"clientRequestQuery"`
+	UserAgent         string    `json:"userAgent"`
+	EdgeColoName      string    `json:"edgeColoName"`
+}
+
+const firewallEventsAdaptiveFields = `{
+        datetime action source ruleId rayName
+        clientIP clientAsn clientCountryName
+        clientRequestHTTPHost clientRequestHTTPMethodName clientRequestPath clientRequestQuery
+        userAgent edgeColoName
+      }`
+
+// FirewallEventsAdaptive returns the firewall events of a zone or account
+// from the firewallEventsAdaptive dataset, in order of time.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) FirewallEventsAdaptive(opts GraphQLAnalyticsOptions) ([]FirewallEvent, error) {
+	return api.FirewallEventsAdaptiveContext(context.TODO(), opts)
+}
+
+// FirewallEventsAdaptiveContext is like FirewallEventsAdaptive but takes a
+// context.
+func (api *API) FirewallEventsAdaptiveContext(ctx context.Context, opts GraphQLAnalyticsOptions) ([]FirewallEvent, error) {
+	var events []FirewallEvent
+	err := api.queryDataset(ctx, "firewallEventsAdaptive", firewallEventsAdaptiveFields, opts, &events)
+	return events, err
+}
@@ -0,0 +1,194 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var req GraphQLRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "query ($zoneTag: string) { viewer { zones(filter: {zoneTag: $zoneTag}) { zoneTag } } }", req.Query)
+		assert.Equal(t, map[string]interface{}{"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353"}, req.Variables)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": {"viewer": {"zones": [{"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353"}]}}, "errors": null}`)
+	})
+
+	var data struct {
+		Viewer struct {
+			Zones []struct {
+				ZoneTag string `json:"zoneTag"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	}
+	err := client.GraphQL(GraphQLRequest{
+		Query:     "query ($zoneTag: string) { viewer { zones(filter: {zoneTag: $zoneTag}) { zoneTag } } }",
+		Variables: map[string]interface{}{"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353"},
+	}, &data)
+	if assert.NoError(t, err) && assert.Len(t, data.Viewer.Zones, 1) {
+		assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", data.Viewer.Zones[0].ZoneTag)
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {"viewer": {"zones": [{"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353", "firewallEventsAdaptive": null}]}},
+			"errors": [{
+				"message": "cannot request data older than 2678400s",
+				"path": ["viewer", "zones", 0, "firewallEventsAdaptive"],
+				"extensions": {"code": "authz", "timestamp": "2019-10-01T00:00:00Z"}
+			}]
+		}`)
+	})
+
+	var data struct {
+		Viewer struct {
+			Zones []struct {
+				ZoneTag string `json:"zoneTag"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	}
+	err := client.GraphQL(GraphQLRequest{Query: "{ viewer { zones { zoneTag firewallEventsAdaptive { action } } } }"}, &data)
+	assert.EqualError(t, err, "viewer.zones.0.firewallEventsAdaptive: cannot request data older than 2678400s")
+	if errs, ok := err.(GraphQLErrors); assert.True(t, ok) && assert.Len(t, errs, 1) {
+		assert.Equal(t, "authz", errs[0].Extensions["code"])
+	}
+	if assert.Len(t, data.Viewer.Zones, 1, "partial data is decoded") {
+		assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", data.Viewer.Zones[0].ZoneTag)
+	}
+}
+
+func TestGraphQL_RetriesServerErrors(t *testing.T) {
+	setup(UsingRetryPolicy(1, 0, 0))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("content-type", "application/json")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"data": {}, "errors": null}`)
+	})
+
+	assert.NoError(t, client.GraphQL(GraphQLRequest{Query: "{ viewer { zones { zoneTag } } }"}, nil))
+	assert.Equal(t, 2, attempts)
+}
+
+func TestHTTPRequests1hGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "$filter: ZoneHttpRequests1hGroupsFilter_InputObject")
+		assert.Contains(t, req.Query, "zones(filter: {zoneTag: $tag})")
+		assert.Contains(t, req.Query, "httpRequests1hGroups(limit: $limit, filter: $filter, orderBy: [datetime_ASC])")
+		assert.Equal(t, map[string]interface{}{
+			"tag": "023e105f4ecef8ad9ca31a8372d0c353",
+			"filter": map[string]interface{}{
+				"datetime_geq": "2019-10-01T00:00:00Z",
+				"datetime_lt":  "2019-10-02T00:00:00Z",
+			},
+			"limit": float64(10000),
+		}, req.Variables)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": {"viewer": {"zones": [{"httpRequests1hGroups": [{
+			"dimensions": {"datetime": "2019-10-01T00:00:00Z"},
+			"sum": {
+				"requests": 1000, "cachedRequests": 600, "bytes": 123456, "threats": 2, "pageViews": 400,
+				"countryMap": [{"clientCountryName": "US", "requests": 700, "bytes": 100000, "threats": 1}],
+				"responseStatusMap": [{"edgeResponseStatus": 200, "requests": 990}]
+			},
+			"uniq": {"uniques": 50}
+		}]}]}}, "errors": null}`)
+	})
+
+	since := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	groups, err := client.HTTPRequests1hGroups(GraphQLAnalyticsOptions{
+		ZoneTag: "023e105f4ecef8ad9ca31a8372d0c353",
+		Since:   since,
+		Until:   since.AddDate(0, 0, 1),
+	})
+	if assert.NoError(t, err) && assert.Len(t, groups, 1) {
+		assert.Equal(t, since, groups[0].Dimensions.Datetime)
+		assert.Equal(t, int64(1000), groups[0].Sum.Requests)
+		assert.Equal(t, []HTTPRequestsCountry{{ClientCountryName: "US", Requests: 700, Bytes: 100000, Threats: 1}}, groups[0].Sum.CountryMap)
+		assert.Equal(t, []HTTPRequestsStatus{{EdgeResponseStatus: 200, Requests: 990}}, groups[0].Sum.ResponseStatusMap)
+		assert.Equal(t, int64(50), groups[0].Uniq.Uniques)
+	}
+
+	_, err = client.HTTPRequests1hGroups(GraphQLAnalyticsOptions{Since: since})
+	assert.EqualError(t, err, "one of ZoneTag and AccountTag must be set")
+	_, err = client.HTTPRequests1hGroups(GraphQLAnalyticsOptions{ZoneTag: "a", AccountTag: "b", Since: since})
+	assert.EqualError(t, err, "only one of ZoneTag and AccountTag may be set")
+}
+
+func TestFirewallEventsAdaptive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "$filter: AccountFirewallEventsAdaptiveFilter_InputObject")
+		assert.Contains(t, req.Query, "accounts(filter: {accountTag: $tag})")
+		assert.Equal(t, "01a7362d577a6c3019a474fd6f485823", req.Variables["tag"])
+		assert.Equal(t, float64(100), req.Variables["limit"])
+		assert.Equal(t, "block", req.Variables["filter"].(map[string]interface{})["action"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": {"viewer": {"accounts": [{"firewallEventsAdaptive": [{
+			"datetime": "2019-10-01T12:00:00Z", "action": "block", "source": "firewallrules",
+			"ruleId": "372e67954025e0ba6aaa6d586b9e0b60", "rayName": "52a3f1a2dc4a0e2d",
+			"clientIP": "198.51.100.4", "clientAsn": "64496", "clientCountryName": "NL",
+			"clientRequestHTTPHost": "www.example.com", "clientRequestHTTPMethodName": "GET",
+			"clientRequestPath": "/wp-login.php", "clientRequestQuery": "",
+			"userAgent": "curl/7.64.1", "edgeColoName": "AMS"
+		}]}]}}, "errors": null}`)
+	})
+
+	events, err := client.FirewallEventsAdaptive(GraphQLAnalyticsOptions{
+		AccountTag: "01a7362d577a6c3019a474fd6f485823",
+		Since:      time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      100,
+		Filter:     map[string]interface{}{"action": "block"},
+	})
+	if assert.NoError(t, err) && assert.Len(t, events, 1) {
+		assert.Equal(t, FirewallEvent{
+			Datetime:          time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC),
+			Action:            "block",
+			Source:            "firewallrules",
+			RuleID:            "372e67954025e0ba6aaa6d586b9e0b60",
+			RayName:           "52a3f1a2dc4a0e2d",
+			ClientIP:          "198.51.100.4",
+			ClientASN:         "64496",
+			ClientCountryName: "NL",
+			Host:              "www.example.com",
+			Method:            "GET",
+			Path:              "/wp-login.php",
+			UserAgent:         "curl/7.64.1",
+			EdgeColoName:      "AMS",
+		}, events[0])
+	}
+}
@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestEvent describes a single attempt at an API request. It is passed to
+// the functions of a RequestHooks.
+type RequestEvent struct {
+	// Method and URI identify the request. URI is relative to the client's
+	// BaseURL.
+	Method string
+	URI    string
+	// Attempt is the 1-based attempt number; it is greater than 1 for
+	// retries.
+	Attempt int
+
+	// Request is the outgoing request. It is set for AfterResponse.
+	Request *http.Request
+	// Response, StatusCode and Err hold the outcome of the attempt. Response
+	// is nil if Err is set. They are set for AfterResponse and OnRetry.
+	Response   *http.Response
+	StatusCode int
+	Err        error
+	// Latency is the time taken to receive the response headers. It is set
+	// for AfterResponse.
+	Latency time.Duration
+	// RetryDelay is how long the client will wait before the next attempt.
+	// It is set for OnRetry.
+	RetryDelay time.Duration
+}
+
+// RequestHooks are callbacks invoked around every HTTP request the client
+// makes, including retries. They can be used to add tracing, metrics, request
+// signing or audit logging without replacing the http.Client. Any of the
+// functions may be nil.
+//
+// Hooks must not read or close response bodies.
+type RequestHooks struct {
+	// BeforeRequest is called once all headers have been set, just before
+	// the request is sent. It may modify req or return a replacement for it
+	// (for example, one carrying a tracing span in its context). Returning an
+	// error aborts the attempt.
+	BeforeRequest func(req *http.Request, event RequestEvent) (*http.Request, error)
+	// AfterResponse is called when an attempt completes, successfully or
+	// not. ctx is the context of the request returned by BeforeRequest.
+	AfterResponse func(ctx context.Context, event RequestEvent)
+	// OnRetry is called when a failed attempt is about to be retried, before
+	// the client waits RetryDelay.
+	OnRetry func(ctx context.Context, event RequestEvent)
+}
+
+// requestHooksChain runs several RequestHooks. BeforeRequest hooks run in the
+// order they were added and AfterResponse hooks in reverse, so hooks nest like
+// HTTP middleware.
+type requestHooksChain []RequestHooks
+
+func (c requestHooksChain) beforeRequest(req *http.Request, event RequestEvent) (*http.Request, error) {
+	for _, h := range c {
+		if h.BeforeRequest == nil {
+			continue
+		}
+		r, err := h.BeforeRequest(req, event)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			req = r
+		}
+	}
+	return req, nil
+}
+
+func (c requestHooksChain) afterResponse(ctx context.Context, event RequestEvent) {
+	for i := len(c) - 1; i >= 0; i-- {
+		if c[i].AfterResponse != nil {
+			c[i].AfterResponse(ctx, event)
+		}
+	}
+}
+
+func (c requestHooksChain) onRetry(ctx context.Context, event RequestEvent) {
+	for _, h := range c {
+		if h.OnRetry != nil {
+			h.OnRetry(ctx, event)
+		}
+	}
+}
+
+// statusCode returns the status code of resp, or 0 if it is nil.
+func statusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
@@ -0,0 +1,101 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestHooks(t *testing.T) {
+	var calls []string
+	var events []RequestEvent
+
+	signer := RequestHooks{
+		BeforeRequest: func(req *http.Request, event RequestEvent) (*http.Request, error) {
+			calls = append(calls, fmt.Sprintf("signer.before %d", event.Attempt))
+			req.Header.Set("X-Signature", "signed-"+event.Method)
+			return req, nil
+		},
+		AfterResponse: func(ctx context.Context, event RequestEvent) {
+			calls = append(calls, fmt.Sprintf("signer.after %d", event.Attempt))
+		},
+	}
+	recorder := RequestHooks{
+		BeforeRequest: func(req *http.Request, event RequestEvent) (*http.Request, error) {
+			calls = append(calls, fmt.Sprintf("recorder.before %d", event.Attempt))
+			return nil, nil
+		},
+		AfterResponse: func(ctx context.Context, event RequestEvent) {
+			calls = append(calls, fmt.Sprintf("recorder.after %d", event.Attempt))
+			events = append(events, event)
+		},
+		OnRetry: func(ctx context.Context, event RequestEvent) {
+			calls = append(calls, fmt.Sprintf("recorder.retry %d", event.Attempt))
+			assert.Equal(t, http.StatusServiceUnavailable, event.StatusCode)
+		},
+	}
+
+	setup(UsingRetryPolicy(1, 0, 0), UsingRequestHooks(signer), UsingRequestHooks(recorder))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "signed-GET", r.Header.Get("X-Signature"))
+		requestsReceived++
+		if requestsReceived == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.UserDetails()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"signer.before 1",
+		"recorder.before 1",
+		"recorder.after 1",
+		"signer.after 1",
+		"recorder.retry 2",
+		"signer.before 2",
+		"recorder.before 2",
+		"recorder.after 2",
+		"signer.after 2",
+	}, calls)
+
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "GET", events[0].Method)
+		assert.Equal(t, "/user", events[0].URI)
+		assert.Equal(t, http.StatusServiceUnavailable, events[0].StatusCode)
+		assert.Equal(t, http.StatusOK, events[1].StatusCode)
+		assert.NotNil(t, events[1].Request)
+		assert.True(t, events[1].Latency > 0)
+	}
+}
+
+func TestRequestHooks_BeforeRequestError(t *testing.T) {
+	hooks := RequestHooks{
+		BeforeRequest: func(req *http.Request, event RequestEvent) (*http.Request, error) {
+			return nil, errors.New("signing key unavailable")
+		},
+	}
+	setup(UsingRequestHooks(hooks))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+	})
+
+	_, err := client.UserDetails()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "signing key unavailable")
+	}
+	assert.Equal(t, 0, requestsReceived)
+}
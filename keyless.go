@@ -1,6 +1,12 @@
 package cloudflare
 
-import "time"
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // KeylessSSL represents Keyless SSL configuration.
 type KeylessSSL struct {
@@ -8,11 +14,11 @@ type KeylessSSL struct {
 	Name        string    `json:"name"`
 	Host        string    `json:"host"`
 	Port        int       `json:"port"`
-	Status      string    `json:"success"`
+	Status      string    `json:"status"`
 	Enabled     bool      `json:"enabled"`
 	Permissions []string  `json:"permissions"`
 	CreatedOn   time.Time `json:"created_on"`
-	ModifiedOn  time.Time `json:"modifed_on"`
+	ModifiedOn  time.Time `json:"modified_on"`
 }
 
 // KeylessSSLResponse represents the response from the Keyless SSL endpoint.
@@ -21,32 +27,133 @@ type KeylessSSLResponse struct {
 	Result []KeylessSSL `json:"result"`
 }
 
+// KeylessSSLDetailResponse represents the response from the Keyless SSL
+// endpoint for a single configuration.
+type KeylessSSLDetailResponse struct {
+	Response
+	Result KeylessSSL `json:"result"`
+}
+
+// KeylessSSLOptions represents the parameters to create a Keyless SSL
+// configuration.
+type KeylessSSLOptions struct {
+	// Host and Port are where the key server can be reached.
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	// Certificate is the zone's SSL certificate, in PEM format, whose
+	// private key is held by the key server.
+	Certificate string `json:"certificate"`
+	Name        string `json:"name,omitempty"`
+	// BundleMethod is "ubiquitous" (the default), "optimal" or "force".
+	BundleMethod string `json:"bundle_method,omitempty"`
+}
+
+// KeylessSSLUpdateOptions represents the parameters to update an existing
+// Keyless SSL configuration. Empty fields are left unchanged.
+type KeylessSSLUpdateOptions struct {
+	Host    string `json:"host,omitempty"`
+	Port    int    `json:"port,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
 // CreateKeyless creates a new Keyless SSL configuration for the zone.
 //
 // API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-create-a-keyless-ssl-configuration
-func (api *API) CreateKeyless() {
+func (api *API) CreateKeyless(zoneID string, options KeylessSSLOptions) (KeylessSSL, error) {
+	return api.CreateKeylessContext(context.TODO(), zoneID, options)
+}
+
+// CreateKeylessContext is like CreateKeyless but takes a context.
+func (api *API) CreateKeylessContext(ctx context.Context, zoneID string, options KeylessSSLOptions) (KeylessSSL, error) {
+	uri := "/zones/" + zoneID + "/keyless_certificates"
+	res, err := api.makeRequestContext(ctx, "POST", uri, options)
+	if err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r KeylessSSLDetailResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
 }
 
 // ListKeyless lists Keyless SSL configurations for a zone.
 //
 // API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-list-keyless-ssls
-func (api *API) ListKeyless() {
+func (api *API) ListKeyless(zoneID string) ([]KeylessSSL, error) {
+	return api.ListKeylessContext(context.TODO(), zoneID)
+}
+
+// ListKeylessContext is like ListKeyless but takes a context.
+func (api *API) ListKeylessContext(ctx context.Context, zoneID string) ([]KeylessSSL, error) {
+	uri := "/zones/" + zoneID + "/keyless_certificates"
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r KeylessSSLResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
 }
 
 // Keyless provides the configuration for a given Keyless SSL identifier.
 //
 // API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-keyless-ssl-details
-func (api *API) Keyless() {
+func (api *API) Keyless(zoneID, keylessID string) (KeylessSSL, error) {
+	return api.KeylessContext(context.TODO(), zoneID, keylessID)
+}
+
+// KeylessContext is like Keyless but takes a context.
+func (api *API) KeylessContext(ctx context.Context, zoneID, keylessID string) (KeylessSSL, error) {
+	uri := "/zones/" + zoneID + "/keyless_certificates/" + keylessID
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r KeylessSSLDetailResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
 }
 
 // UpdateKeyless updates an existing Keyless SSL configuration.
 //
 // API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-update-keyless-configuration
-func (api *API) UpdateKeyless() {
+func (api *API) UpdateKeyless(zoneID, keylessID string, options KeylessSSLUpdateOptions) (KeylessSSL, error) {
+	return api.UpdateKeylessContext(context.TODO(), zoneID, keylessID, options)
+}
+
+// UpdateKeylessContext is like UpdateKeyless but takes a context.
+func (api *API) UpdateKeylessContext(ctx context.Context, zoneID, keylessID string, options KeylessSSLUpdateOptions) (KeylessSSL, error) {
+	uri := "/zones/" + zoneID + "/keyless_certificates/" + keylessID
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, options)
+	if err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r KeylessSSLDetailResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return KeylessSSL{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
 }
 
 // DeleteKeyless deletes an existing Keyless SSL configuration.
 //
 // API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-delete-keyless-configuration
-func (api *API) DeleteKeyless() {
+func (api *API) DeleteKeyless(zoneID, keylessID string) error {
+	return api.DeleteKeylessContext(context.TODO(), zoneID, keylessID)
+}
+
+// DeleteKeylessContext is like DeleteKeyless but takes a context.
+func (api *API) DeleteKeylessContext(ctx context.Context, zoneID, keylessID string) error {
+	uri := "/zones/" + zoneID + "/keyless_certificates/" + keylessID
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
 }
@@ -0,0 +1,148 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const keylessSSLJSON = `{
+  "id": "4d2844d2ce78891c34d0b6c0535a291e",
+  "name": "example.com Keyless SSL",
+  "host": "example.com",
+  "port": 24008,
+  "status": "active",
+  "enabled": false,
+  "permissions": ["#ssl:read", "#ssl:edit"],
+  "created_on": "2014-01-01T05:20:00Z",
+  "modified_on": "2014-01-01T05:20:00Z"
+}`
+
+var expectedKeylessSSL = KeylessSSL{
+	ID:          "4d2844d2ce78891c34d0b6c0535a291e",
+	Name:        "example.com Keyless SSL",
+	Host:        "example.com",
+	Port:        24008,
+	Status:      "active",
+	Enabled:     false,
+	Permissions: []string{"#ssl:read", "#ssl:edit"},
+	CreatedOn:   time.Date(2014, 1, 1, 5, 20, 0, 0, time.UTC),
+	ModifiedOn:  time.Date(2014, 1, 1, 5, 20, 0, 0, time.UTC),
+}
+
+func TestCreateKeyless(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"host": "example.com",
+				"port": 24008,
+				"certificate": "-----BEGIN CERTIFICATE-----\nMIIDtTCCAp2gAwIBAgIJAM15n7fdxhRtMA0GCSqGSIb3DQEBBQUAMEUxCzAJBgNV\n-----END CERTIFICATE-----\n",
+				"name": "example.com Keyless SSL",
+				"bundle_method": "ubiquitous"
+			}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, keylessSSLJSON)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/keyless_certificates", handler)
+
+	actual, err := client.CreateKeyless("023e105f4ecef8ad9ca31a8372d0c353", KeylessSSLOptions{
+		Host:         "example.com",
+		Port:         24008,
+		Certificate:  "-----BEGIN CERTIFICATE-----\nMIIDtTCCAp2gAwIBAgIJAM15n7fdxhRtMA0GCSqGSIb3DQEBBQUAMEUxCzAJBgNV\n-----END CERTIFICATE-----\n",
+		Name:         "example.com Keyless SSL",
+		BundleMethod: "ubiquitous",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedKeylessSSL, actual)
+	}
+}
+
+func TestListKeyless(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, keylessSSLJSON)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/keyless_certificates", handler)
+
+	actual, err := client.ListKeyless("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []KeylessSSL{expectedKeylessSSL}, actual)
+	}
+}
+
+func TestKeyless(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, keylessSSLJSON)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/keyless_certificates/4d2844d2ce78891c34d0b6c0535a291e", handler)
+
+	actual, err := client.Keyless("023e105f4ecef8ad9ca31a8372d0c353", "4d2844d2ce78891c34d0b6c0535a291e")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedKeylessSSL, actual)
+	}
+}
+
+func TestUpdateKeyless(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"port": 24009, "enabled": false}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, keylessSSLJSON)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/keyless_certificates/4d2844d2ce78891c34d0b6c0535a291e", handler)
+
+	enabled := false
+	actual, err := client.UpdateKeyless("023e105f4ecef8ad9ca31a8372d0c353", "4d2844d2ce78891c34d0b6c0535a291e", KeylessSSLUpdateOptions{
+		Port:    24009,
+		Enabled: &enabled,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedKeylessSSL, actual)
+	}
+}
+
+func TestDeleteKeyless(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4d2844d2ce78891c34d0b6c0535a291e"}}`)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/keyless_certificates/4d2844d2ce78891c34d0b6c0535a291e", handler)
+
+	err := client.DeleteKeyless("023e105f4ecef8ad9ca31a8372d0c353", "4d2844d2ce78891c34d0b6c0535a291e")
+	assert.NoError(t, err)
+
+	err = client.DeleteKeyless("023e105f4ecef8ad9ca31a8372d0c353", "bar")
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -149,8 +150,13 @@ type loadBalancerPoolHealthResponse struct {
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-create-a-pool
 func (api *API) CreateLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool, error) {
+	return api.CreateLoadBalancerPoolContext(context.TODO(), pool)
+}
+
+// CreateLoadBalancerPoolContext is like CreateLoadBalancerPool but takes a context.
+func (api *API) CreateLoadBalancerPoolContext(ctx context.Context, pool LoadBalancerPool) (LoadBalancerPool, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools"
-	res, err := api.makeRequest("POST", uri, pool)
+	res, err := api.makeRequestContext(ctx, "POST", uri, pool)
 	if err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -165,8 +171,13 @@ func (api *API) CreateLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool,
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-list-pools
 func (api *API) ListLoadBalancerPools() ([]LoadBalancerPool, error) {
+	return api.ListLoadBalancerPoolsContext(context.TODO())
+}
+
+// ListLoadBalancerPoolsContext is like ListLoadBalancerPools but takes a context.
+func (api *API) ListLoadBalancerPoolsContext(ctx context.Context) ([]LoadBalancerPool, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -181,8 +192,13 @@ func (api *API) ListLoadBalancerPools() ([]LoadBalancerPool, error) {
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-pool-details
 func (api *API) LoadBalancerPoolDetails(poolID string) (LoadBalancerPool, error) {
+	return api.LoadBalancerPoolDetailsContext(context.TODO(), poolID)
+}
+
+// LoadBalancerPoolDetailsContext is like LoadBalancerPoolDetails but takes a context.
+func (api *API) LoadBalancerPoolDetailsContext(ctx context.Context, poolID string) (LoadBalancerPool, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools/" + poolID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -197,8 +213,13 @@ func (api *API) LoadBalancerPoolDetails(poolID string) (LoadBalancerPool, error)
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-delete-a-pool
 func (api *API) DeleteLoadBalancerPool(poolID string) error {
+	return api.DeleteLoadBalancerPoolContext(context.TODO(), poolID)
+}
+
+// DeleteLoadBalancerPoolContext is like DeleteLoadBalancerPool but takes a context.
+func (api *API) DeleteLoadBalancerPoolContext(ctx context.Context, poolID string) error {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools/" + poolID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	return nil
@@ -208,8 +229,13 @@ func (api *API) DeleteLoadBalancerPool(poolID string) error {
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-modify-a-pool
 func (api *API) ModifyLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool, error) {
+	return api.ModifyLoadBalancerPoolContext(context.TODO(), pool)
+}
+
+// ModifyLoadBalancerPoolContext is like ModifyLoadBalancerPool but takes a context.
+func (api *API) ModifyLoadBalancerPoolContext(ctx context.Context, pool LoadBalancerPool) (LoadBalancerPool, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools/" + pool.ID
-	res, err := api.makeRequest("PUT", uri, pool)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, pool)
 	if err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -224,8 +250,13 @@ func (api *API) ModifyLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool,
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-create-a-monitor
 func (api *API) CreateLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
+	return api.CreateLoadBalancerMonitorContext(context.TODO(), monitor)
+}
+
+// CreateLoadBalancerMonitorContext is like CreateLoadBalancerMonitor but takes a context.
+func (api *API) CreateLoadBalancerMonitorContext(ctx context.Context, monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/monitors"
-	res, err := api.makeRequest("POST", uri, monitor)
+	res, err := api.makeRequestContext(ctx, "POST", uri, monitor)
 	if err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -240,8 +271,13 @@ func (api *API) CreateLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBala
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-list-monitors
 func (api *API) ListLoadBalancerMonitors() ([]LoadBalancerMonitor, error) {
+	return api.ListLoadBalancerMonitorsContext(context.TODO())
+}
+
+// ListLoadBalancerMonitorsContext is like ListLoadBalancerMonitors but takes a context.
+func (api *API) ListLoadBalancerMonitorsContext(ctx context.Context) ([]LoadBalancerMonitor, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/monitors"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -256,8 +292,13 @@ func (api *API) ListLoadBalancerMonitors() ([]LoadBalancerMonitor, error) {
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-monitor-details
 func (api *API) LoadBalancerMonitorDetails(monitorID string) (LoadBalancerMonitor, error) {
+	return api.LoadBalancerMonitorDetailsContext(context.TODO(), monitorID)
+}
+
+// LoadBalancerMonitorDetailsContext is like LoadBalancerMonitorDetails but takes a context.
+func (api *API) LoadBalancerMonitorDetailsContext(ctx context.Context, monitorID string) (LoadBalancerMonitor, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/monitors/" + monitorID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -272,8 +313,13 @@ func (api *API) LoadBalancerMonitorDetails(monitorID string) (LoadBalancerMonito
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-delete-a-monitor
 func (api *API) DeleteLoadBalancerMonitor(monitorID string) error {
+	return api.DeleteLoadBalancerMonitorContext(context.TODO(), monitorID)
+}
+
+// DeleteLoadBalancerMonitorContext is like DeleteLoadBalancerMonitor but takes a context.
+func (api *API) DeleteLoadBalancerMonitorContext(ctx context.Context, monitorID string) error {
 	uri := api.userBaseURL("/user") + "/load_balancers/monitors/" + monitorID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	return nil
@@ -283,8 +329,13 @@ func (api *API) DeleteLoadBalancerMonitor(monitorID string) error {
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-modify-a-monitor
 func (api *API) ModifyLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
+	return api.ModifyLoadBalancerMonitorContext(context.TODO(), monitor)
+}
+
+// ModifyLoadBalancerMonitorContext is like ModifyLoadBalancerMonitor but takes a context.
+func (api *API) ModifyLoadBalancerMonitorContext(ctx context.Context, monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/monitors/" + monitor.ID
-	res, err := api.makeRequest("PUT", uri, monitor)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, monitor)
 	if err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -299,8 +350,13 @@ func (api *API) ModifyLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBala
 //
 // API reference: https://api.cloudflare.com/#load-balancers-create-a-load-balancer
 func (api *API) CreateLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer, error) {
+	return api.CreateLoadBalancerContext(context.TODO(), zoneID, lb)
+}
+
+// CreateLoadBalancerContext is like CreateLoadBalancer but takes a context.
+func (api *API) CreateLoadBalancerContext(ctx context.Context, zoneID string, lb LoadBalancer) (LoadBalancer, error) {
 	uri := "/zones/" + zoneID + "/load_balancers"
-	res, err := api.makeRequest("POST", uri, lb)
+	res, err := api.makeRequestContext(ctx, "POST", uri, lb)
 	if err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -315,8 +371,13 @@ func (api *API) CreateLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer
 //
 // API reference: https://api.cloudflare.com/#load-balancers-list-load-balancers
 func (api *API) ListLoadBalancers(zoneID string) ([]LoadBalancer, error) {
+	return api.ListLoadBalancersContext(context.TODO(), zoneID)
+}
+
+// ListLoadBalancersContext is like ListLoadBalancers but takes a context.
+func (api *API) ListLoadBalancersContext(ctx context.Context, zoneID string) ([]LoadBalancer, error) {
 	uri := "/zones/" + zoneID + "/load_balancers"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -331,8 +392,13 @@ func (api *API) ListLoadBalancers(zoneID string) ([]LoadBalancer, error) {
 //
 // API reference: https://api.cloudflare.com/#load-balancers-load-balancer-details
 func (api *API) LoadBalancerDetails(zoneID, lbID string) (LoadBalancer, error) {
+	return api.LoadBalancerDetailsContext(context.TODO(), zoneID, lbID)
+}
+
+// LoadBalancerDetailsContext is like LoadBalancerDetails but takes a context.
+func (api *API) LoadBalancerDetailsContext(ctx context.Context, zoneID, lbID string) (LoadBalancer, error) {
 	uri := "/zones/" + zoneID + "/load_balancers/" + lbID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -347,8 +413,13 @@ func (api *API) LoadBalancerDetails(zoneID, lbID string) (LoadBalancer, error) {
 //
 // API reference: https://api.cloudflare.com/#load-balancers-delete-a-load-balancer
 func (api *API) DeleteLoadBalancer(zoneID, lbID string) error {
+	return api.DeleteLoadBalancerContext(context.TODO(), zoneID, lbID)
+}
+
+// DeleteLoadBalancerContext is like DeleteLoadBalancer but takes a context.
+func (api *API) DeleteLoadBalancerContext(ctx context.Context, zoneID, lbID string) error {
 	uri := "/zones/" + zoneID + "/load_balancers/" + lbID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	return nil
@@ -358,8 +429,13 @@ func (api *API) DeleteLoadBalancer(zoneID, lbID string) error {
 //
 // API reference: https://api.cloudflare.com/#load-balancers-modify-a-load-balancer
 func (api *API) ModifyLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer, error) {
+	return api.ModifyLoadBalancerContext(context.TODO(), zoneID, lb)
+}
+
+// ModifyLoadBalancerContext is like ModifyLoadBalancer but takes a context.
+func (api *API) ModifyLoadBalancerContext(ctx context.Context, zoneID string, lb LoadBalancer) (LoadBalancer, error) {
 	uri := "/zones/" + zoneID + "/load_balancers/" + lb.ID
-	res, err := api.makeRequest("PUT", uri, lb)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, lb)
 	if err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -374,8 +450,13 @@ func (api *API) ModifyLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-pool-health-details
 func (api *API) PoolHealthDetails(poolID string) (LoadBalancerPoolHealth, error) {
+	return api.PoolHealthDetailsContext(context.TODO(), poolID)
+}
+
+// PoolHealthDetailsContext is like PoolHealthDetails but takes a context.
+func (api *API) PoolHealthDetailsContext(ctx context.Context, poolID string) (LoadBalancerPoolHealth, error) {
 	uri := api.userBaseURL("/user") + "/load_balancers/pools/" + poolID + "/health"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return LoadBalancerPoolHealth{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -46,8 +47,13 @@ type ZoneLockdownListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-create-a-ZoneLockdown-rule
 func (api *API) CreateZoneLockdown(zoneID string, ld ZoneLockdown) (*ZoneLockdownResponse, error) {
+	return api.CreateZoneLockdownContext(context.TODO(), zoneID, ld)
+}
+
+// CreateZoneLockdownContext is like CreateZoneLockdown but takes a context.
+func (api *API) CreateZoneLockdownContext(ctx context.Context, zoneID string, ld ZoneLockdown) (*ZoneLockdownResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/lockdowns"
-	res, err := api.makeRequest("POST", uri, ld)
+	res, err := api.makeRequestContext(ctx, "POST", uri, ld)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -66,8 +72,13 @@ func (api *API) CreateZoneLockdown(zoneID string, ld ZoneLockdown) (*ZoneLockdow
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-update-ZoneLockdown-rule
 func (api *API) UpdateZoneLockdown(zoneID string, id string, ld ZoneLockdown) (*ZoneLockdownResponse, error) {
+	return api.UpdateZoneLockdownContext(context.TODO(), zoneID, id, ld)
+}
+
+// UpdateZoneLockdownContext is like UpdateZoneLockdown but takes a context.
+func (api *API) UpdateZoneLockdownContext(ctx context.Context, zoneID string, id string, ld ZoneLockdown) (*ZoneLockdownResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/lockdowns/" + id
-	res, err := api.makeRequest("PUT", uri, ld)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, ld)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -86,8 +97,13 @@ func (api *API) UpdateZoneLockdown(zoneID string, id string, ld ZoneLockdown) (*
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-delete-ZoneLockdown-rule
 func (api *API) DeleteZoneLockdown(zoneID string, id string) (*ZoneLockdownResponse, error) {
+	return api.DeleteZoneLockdownContext(context.TODO(), zoneID, id)
+}
+
+// DeleteZoneLockdownContext is like DeleteZoneLockdown but takes a context.
+func (api *API) DeleteZoneLockdownContext(ctx context.Context, zoneID string, id string) (*ZoneLockdownResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/lockdowns/" + id
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -106,8 +122,13 @@ func (api *API) DeleteZoneLockdown(zoneID string, id string) (*ZoneLockdownRespo
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-ZoneLockdown-rule-details
 func (api *API) ZoneLockdown(zoneID string, id string) (*ZoneLockdownResponse, error) {
+	return api.ZoneLockdownContext(context.TODO(), zoneID, id)
+}
+
+// ZoneLockdownContext is like ZoneLockdown but takes a context.
+func (api *API) ZoneLockdownContext(ctx context.Context, zoneID string, id string) (*ZoneLockdownResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/lockdowns/" + id
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -126,6 +147,11 @@ func (api *API) ZoneLockdown(zoneID string, id string) (*ZoneLockdownResponse, e
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-list-ZoneLockdown-rules
 func (api *API) ListZoneLockdowns(zoneID string, page int) (*ZoneLockdownListResponse, error) {
+	return api.ListZoneLockdownsContext(context.TODO(), zoneID, page)
+}
+
+// ListZoneLockdownsContext is like ListZoneLockdowns but takes a context.
+func (api *API) ListZoneLockdownsContext(ctx context.Context, zoneID string, page int) (*ZoneLockdownListResponse, error) {
 	v := url.Values{}
 	if page <= 0 {
 		page = 1
@@ -136,7 +162,7 @@ func (api *API) ListZoneLockdowns(zoneID string, page int) (*ZoneLockdownListRes
 	query := "?" + v.Encode()
 
 	uri := "/zones/" + zoneID + "/firewall/lockdowns" + query
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -149,3 +175,36 @@ func (api *API) ListZoneLockdowns(zoneID string, page int) (*ZoneLockdownListRes
 
 	return response, nil
 }
+
+// ZoneLockdownIterator iterates over zone lockdowns. See Paginator.
+type ZoneLockdownIterator struct {
+	*Paginator
+}
+
+// Value returns the current zone lockdown.
+func (it *ZoneLockdownIterator) Value() ZoneLockdown {
+	v, _ := it.value().(ZoneLockdown)
+	return v
+}
+
+// All returns every remaining zone lockdown, fetching up to concurrency pages
+// at a time.
+func (it *ZoneLockdownIterator) All(concurrency int) ([]ZoneLockdown, error) {
+	var lockdowns []ZoneLockdown
+	if err := it.collect(concurrency, &lockdowns); err != nil {
+		return nil, err
+	}
+	return lockdowns, nil
+}
+
+// ListZoneLockdownsIter returns an iterator over the zone lockdown rules of a
+// zone.
+func (api *API) ListZoneLockdownsIter(ctx context.Context, zoneID string) *ZoneLockdownIterator {
+	return &ZoneLockdownIterator{newPaginator(ctx, PaginationOptions{}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		r, err := api.ListZoneLockdownsContext(ctx, zoneID, pageOpts.Page)
+		if err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return r.Result, r.ResultInfo, nil
+	})}
+}
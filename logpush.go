@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 	"time"
@@ -84,8 +85,13 @@ type LogpushDestinationExistsRequest struct {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-create-logpush-job
 func (api *API) CreateLogpushJob(zoneID string, job LogpushJob) (*LogpushJob, error) {
+	return api.CreateLogpushJobContext(context.TODO(), zoneID, job)
+}
+
+// CreateLogpushJobContext is like CreateLogpushJob but takes a context.
+func (api *API) CreateLogpushJobContext(ctx context.Context, zoneID string, job LogpushJob) (*LogpushJob, error) {
 	uri := "/zones/" + zoneID + "/logpush/jobs"
-	res, err := api.makeRequest("POST", uri, job)
+	res, err := api.makeRequestContext(ctx, "POST", uri, job)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -101,8 +107,13 @@ func (api *API) CreateLogpushJob(zoneID string, job LogpushJob) (*LogpushJob, er
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs
 func (api *API) LogpushJobs(zoneID string) ([]LogpushJob, error) {
+	return api.LogpushJobsContext(context.TODO(), zoneID)
+}
+
+// LogpushJobsContext is like LogpushJobs but takes a context.
+func (api *API) LogpushJobsContext(ctx context.Context, zoneID string) ([]LogpushJob, error) {
 	uri := "/zones/" + zoneID + "/logpush/jobs"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []LogpushJob{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -118,8 +129,13 @@ func (api *API) LogpushJobs(zoneID string) ([]LogpushJob, error) {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-logpush-job-details
 func (api *API) LogpushJob(zoneID string, jobID int) (LogpushJob, error) {
+	return api.LogpushJobContext(context.TODO(), zoneID, jobID)
+}
+
+// LogpushJobContext is like LogpushJob but takes a context.
+func (api *API) LogpushJobContext(ctx context.Context, zoneID string, jobID int) (LogpushJob, error) {
 	uri := "/zones/" + zoneID + "/logpush/jobs/" + strconv.Itoa(jobID)
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return LogpushJob{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -135,8 +151,13 @@ func (api *API) LogpushJob(zoneID string, jobID int) (LogpushJob, error) {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-update-logpush-job
 func (api *API) UpdateLogpushJob(zoneID string, jobID int, job LogpushJob) error {
+	return api.UpdateLogpushJobContext(context.TODO(), zoneID, jobID, job)
+}
+
+// UpdateLogpushJobContext is like UpdateLogpushJob but takes a context.
+func (api *API) UpdateLogpushJobContext(ctx context.Context, zoneID string, jobID int, job LogpushJob) error {
 	uri := "/zones/" + zoneID + "/logpush/jobs/" + strconv.Itoa(jobID)
-	res, err := api.makeRequest("PUT", uri, job)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, job)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -152,8 +173,13 @@ func (api *API) UpdateLogpushJob(zoneID string, jobID int, job LogpushJob) error
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-delete-logpush-job
 func (api *API) DeleteLogpushJob(zoneID string, jobID int) error {
+	return api.DeleteLogpushJobContext(context.TODO(), zoneID, jobID)
+}
+
+// DeleteLogpushJobContext is like DeleteLogpushJob but takes a context.
+func (api *API) DeleteLogpushJobContext(ctx context.Context, zoneID string, jobID int) error {
 	uri := "/zones/" + zoneID + "/logpush/jobs/" + strconv.Itoa(jobID)
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -169,8 +195,13 @@ func (api *API) DeleteLogpushJob(zoneID string, jobID int) error {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-get-ownership-challenge
 func (api *API) GetLogpushOwnershipChallenge(zoneID, destinationConf string) (*LogpushGetOwnershipChallenge, error) {
+	return api.GetLogpushOwnershipChallengeContext(context.TODO(), zoneID, destinationConf)
+}
+
+// GetLogpushOwnershipChallengeContext is like GetLogpushOwnershipChallenge but takes a context.
+func (api *API) GetLogpushOwnershipChallengeContext(ctx context.Context, zoneID, destinationConf string) (*LogpushGetOwnershipChallenge, error) {
 	uri := "/zones/" + zoneID + "/logpush/ownership"
-	res, err := api.makeRequest("POST", uri, LogpushGetOwnershipChallengeRequest{
+	res, err := api.makeRequestContext(ctx, "POST", uri, LogpushGetOwnershipChallengeRequest{
 		DestinationConf: destinationConf,
 	})
 	if err != nil {
@@ -188,8 +219,13 @@ func (api *API) GetLogpushOwnershipChallenge(zoneID, destinationConf string) (*L
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-validate-ownership-challenge
 func (api *API) ValidateLogpushOwnershipChallenge(zoneID, destinationConf, ownershipChallenge string) (bool, error) {
+	return api.ValidateLogpushOwnershipChallengeContext(context.TODO(), zoneID, destinationConf, ownershipChallenge)
+}
+
+// ValidateLogpushOwnershipChallengeContext is like ValidateLogpushOwnershipChallenge but takes a context.
+func (api *API) ValidateLogpushOwnershipChallengeContext(ctx context.Context, zoneID, destinationConf, ownershipChallenge string) (bool, error) {
 	uri := "/zones/" + zoneID + "/logpush/ownership/validate"
-	res, err := api.makeRequest("POST", uri, LogpushValidateOwnershipChallengeRequest{
+	res, err := api.makeRequestContext(ctx, "POST", uri, LogpushValidateOwnershipChallengeRequest{
 		DestinationConf:    destinationConf,
 		OwnershipChallenge: ownershipChallenge,
 	})
@@ -208,8 +244,13 @@ func (api *API) ValidateLogpushOwnershipChallenge(zoneID, destinationConf, owner
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-check-destination-exists
 func (api *API) CheckLogpushDestinationExists(zoneID, destinationConf string) (bool, error) {
+	return api.CheckLogpushDestinationExistsContext(context.TODO(), zoneID, destinationConf)
+}
+
+// CheckLogpushDestinationExistsContext is like CheckLogpushDestinationExists but takes a context.
+func (api *API) CheckLogpushDestinationExistsContext(ctx context.Context, zoneID, destinationConf string) (bool, error) {
 	uri := "/zones/" + zoneID + "/logpush/validate/destination/exists"
-	res, err := api.makeRequest("POST", uri, LogpushDestinationExistsRequest{
+	res, err := api.makeRequestContext(ctx, "POST", uri, LogpushDestinationExistsRequest{
 		DestinationConf: destinationConf,
 	})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/pkg/errors"
 	"golang.org/x/time/rate"
 )
 
@@ -51,15 +52,66 @@ func UsingRateLimit(rps float64) Option {
 }
 
 // UsingRetryPolicy applies a non-default number of retries and min/max retry delays
-// This will be used when the client exponentially backs off after errored requests
+// This will be used when the client backs off after errored requests
 func UsingRetryPolicy(maxRetries int, minRetryDelaySecs int, maxRetryDelaySecs int) Option {
 	// seconds is very granular for a minimum delay - but this is only in case of failure
 	return func(api *API) error {
-		api.retryPolicy = RetryPolicy{
-			MaxRetries:    maxRetries,
-			MinRetryDelay: time.Duration(minRetryDelaySecs) * time.Second,
-			MaxRetryDelay: time.Duration(maxRetryDelaySecs) * time.Second,
+		api.retryPolicy.MaxRetries = maxRetries
+		api.retryPolicy.MinRetryDelay = time.Duration(minRetryDelaySecs) * time.Second
+		api.retryPolicy.MaxRetryDelay = time.Duration(maxRetryDelaySecs) * time.Second
+		return nil
+	}
+}
+
+// UsingRetryBackoff replaces the default backoff, which honours Retry-After
+// headers and otherwise waits a random delay between zero and an exponential
+// delay that starts at the min retry delay and is capped at the max.
+func UsingRetryBackoff(backoff Backoff) Option {
+	return func(api *API) error {
+		api.retryPolicy.Backoff = backoff
+		return nil
+	}
+}
+
+// UsingRetryDecider replaces the default decision of which failed requests
+// are retried. See DefaultRetryDecider.
+func UsingRetryDecider(decider RetryDecider) Option {
+	return func(api *API) error {
+		api.retryPolicy.Decider = decider
+		return nil
+	}
+}
+
+// UsingPaginationConcurrency sets how many pages may be fetched at once by
+// methods that return every page of a list endpoint, such as ListZones and
+// DNSRecords. The default is 4; requests are still subject to the rate limit.
+func UsingPaginationConcurrency(n int) Option {
+	return func(api *API) error {
+		if n < 1 {
+			return errors.New("pagination concurrency must be at least 1")
 		}
+		api.paginationConcurrency = n
+		return nil
+	}
+}
+
+// UsingRequestHooks adds hooks that are called around every HTTP request the
+// client makes. It may be given more than once; hooks run in the order added.
+func UsingRequestHooks(hooks ...RequestHooks) Option {
+	return func(api *API) error {
+		api.hooks = append(api.hooks, hooks...)
+		return nil
+	}
+}
+
+// UsingCredentialProvider makes the client ask provider for credentials
+// before every request instead of using its APIKey, APIToken and so on. The
+// client keeps its authentication method: a client created with New sends the
+// provider's API key and email, and one created with NewWithAPIToken sends
+// its API token.
+func UsingCredentialProvider(provider CredentialProvider) Option {
+	return func(api *API) error {
+		api.credentialProvider = provider
 		return nil
 	}
 }
@@ -53,12 +53,18 @@ type originCACertificateResponseRevoke struct {
 
 // CreateOriginCertificate creates a Cloudflare-signed certificate.
 //
-// This function requires api.APIUserServiceKey be set to your Certificates API key.
+// This function requires api.APIUserServiceKey, or the APIUserServiceKey of the
+// client's CredentialProvider, be set to your Certificates API key.
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca-create-certificate
 func (api *API) CreateOriginCertificate(certificate OriginCACertificate) (*OriginCACertificate, error) {
+	return api.CreateOriginCertificateContext(context.TODO(), certificate)
+}
+
+// CreateOriginCertificateContext is like CreateOriginCertificate but takes a context.
+func (api *API) CreateOriginCertificateContext(ctx context.Context, certificate OriginCACertificate) (*OriginCACertificate, error) {
 	uri := "/certificates"
-	res, err := api.makeRequestWithAuthType(context.TODO(), "POST", uri, certificate, AuthUserService)
+	res, err := api.makeRequestWithAuthType(ctx, "POST", uri, certificate, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -81,16 +87,22 @@ func (api *API) CreateOriginCertificate(certificate OriginCACertificate) (*Origi
 
 // OriginCertificates lists all Cloudflare-issued certificates.
 //
-// This function requires api.APIUserServiceKey be set to your Certificates API key.
+// This function requires api.APIUserServiceKey, or the APIUserServiceKey of the
+// client's CredentialProvider, be set to your Certificates API key.
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca-list-certificates
 func (api *API) OriginCertificates(options OriginCACertificateListOptions) ([]OriginCACertificate, error) {
+	return api.OriginCertificatesContext(context.TODO(), options)
+}
+
+// OriginCertificatesContext is like OriginCertificates but takes a context.
+func (api *API) OriginCertificatesContext(ctx context.Context, options OriginCACertificateListOptions) ([]OriginCACertificate, error) {
 	v := url.Values{}
 	if options.ZoneID != "" {
 		v.Set("zone_id", options.ZoneID)
 	}
 	uri := "/certificates" + "?" + v.Encode()
-	res, err := api.makeRequestWithAuthType(context.TODO(), "GET", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(ctx, "GET", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -113,12 +125,18 @@ func (api *API) OriginCertificates(options OriginCACertificateListOptions) ([]Or
 
 // OriginCertificate returns the details for a Cloudflare-issued certificate.
 //
-// This function requires api.APIUserServiceKey be set to your Certificates API key.
+// This function requires api.APIUserServiceKey, or the APIUserServiceKey of the
+// client's CredentialProvider, be set to your Certificates API key.
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca-certificate-details
 func (api *API) OriginCertificate(certificateID string) (*OriginCACertificate, error) {
+	return api.OriginCertificateContext(context.TODO(), certificateID)
+}
+
+// OriginCertificateContext is like OriginCertificate but takes a context.
+func (api *API) OriginCertificateContext(ctx context.Context, certificateID string) (*OriginCACertificate, error) {
 	uri := "/certificates/" + certificateID
-	res, err := api.makeRequestWithAuthType(context.TODO(), "GET", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(ctx, "GET", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -141,12 +159,18 @@ func (api *API) OriginCertificate(certificateID string) (*OriginCACertificate, e
 
 // RevokeOriginCertificate revokes a created certificate for a zone.
 //
-// This function requires api.APIUserServiceKey be set to your Certificates API key.
+// This function requires api.APIUserServiceKey, or the APIUserServiceKey of the
+// client's CredentialProvider, be set to your Certificates API key.
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca-revoke-certificate
 func (api *API) RevokeOriginCertificate(certificateID string) (*OriginCACertificateID, error) {
+	return api.RevokeOriginCertificateContext(context.TODO(), certificateID)
+}
+
+// RevokeOriginCertificateContext is like RevokeOriginCertificate but takes a context.
+func (api *API) RevokeOriginCertificateContext(ctx context.Context, certificateID string) (*OriginCACertificateID, error) {
 	uri := "/certificates/" + certificateID
-	res, err := api.makeRequestWithAuthType(context.TODO(), "DELETE", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(ctx, "DELETE", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
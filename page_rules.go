@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -134,8 +135,13 @@ type PageRulesResponse struct {
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-create-a-page-rule
 func (api *API) CreatePageRule(zoneID string, rule PageRule) (*PageRule, error) {
+	return api.CreatePageRuleContext(context.TODO(), zoneID, rule)
+}
+
+// CreatePageRuleContext is like CreatePageRule but takes a context.
+func (api *API) CreatePageRuleContext(ctx context.Context, zoneID string, rule PageRule) (*PageRule, error) {
 	uri := "/zones/" + zoneID + "/pagerules"
-	res, err := api.makeRequest("POST", uri, rule)
+	res, err := api.makeRequestContext(ctx, "POST", uri, rule)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -151,8 +157,13 @@ func (api *API) CreatePageRule(zoneID string, rule PageRule) (*PageRule, error)
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-list-page-rules
 func (api *API) ListPageRules(zoneID string) ([]PageRule, error) {
+	return api.ListPageRulesContext(context.TODO(), zoneID)
+}
+
+// ListPageRulesContext is like ListPageRules but takes a context.
+func (api *API) ListPageRulesContext(ctx context.Context, zoneID string) ([]PageRule, error) {
 	uri := "/zones/" + zoneID + "/pagerules"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []PageRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -168,8 +179,13 @@ func (api *API) ListPageRules(zoneID string) ([]PageRule, error) {
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-page-rule-details
 func (api *API) PageRule(zoneID, ruleID string) (PageRule, error) {
+	return api.PageRuleContext(context.TODO(), zoneID, ruleID)
+}
+
+// PageRuleContext is like PageRule but takes a context.
+func (api *API) PageRuleContext(ctx context.Context, zoneID, ruleID string) (PageRule, error) {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return PageRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -186,8 +202,13 @@ func (api *API) PageRule(zoneID, ruleID string) (PageRule, error) {
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-change-a-page-rule
 func (api *API) ChangePageRule(zoneID, ruleID string, rule PageRule) error {
+	return api.ChangePageRuleContext(context.TODO(), zoneID, ruleID, rule)
+}
+
+// ChangePageRuleContext is like ChangePageRule but takes a context.
+func (api *API) ChangePageRuleContext(ctx context.Context, zoneID, ruleID string, rule PageRule) error {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("PATCH", uri, rule)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, rule)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -204,8 +225,13 @@ func (api *API) ChangePageRule(zoneID, ruleID string, rule PageRule) error {
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-update-a-page-rule
 func (api *API) UpdatePageRule(zoneID, ruleID string, rule PageRule) error {
+	return api.UpdatePageRuleContext(context.TODO(), zoneID, ruleID, rule)
+}
+
+// UpdatePageRuleContext is like UpdatePageRule but takes a context.
+func (api *API) UpdatePageRuleContext(ctx context.Context, zoneID, ruleID string, rule PageRule) error {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("PUT", uri, rule)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, rule)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -221,8 +247,13 @@ func (api *API) UpdatePageRule(zoneID, ruleID string, rule PageRule) error {
 //
 // API reference: https://api.cloudflare.com/#page-rules-for-a-zone-delete-a-page-rule
 func (api *API) DeletePageRule(zoneID, ruleID string) error {
+	return api.DeletePageRuleContext(context.TODO(), zoneID, ruleID)
+}
+
+// DeletePageRuleContext is like DeletePageRule but takes a context.
+func (api *API) DeletePageRuleContext(ctx context.Context, zoneID, ruleID string) error {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
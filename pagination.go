@@ -0,0 +1,242 @@
+package cloudflare
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// pageFetcher fetches a single page of a list endpoint. results must be a
+// slice holding the results on the page.
+type pageFetcher func(ctx context.Context, pageOpts PaginationOptions) (results interface{}, info ResultInfo, err error)
+
+// Paginator walks every page of a list endpoint, fetching pages as they are
+// needed. It is embedded by the resource iterators (DNSRecordIterator,
+// ZoneIterator and so on), which add a typed Value method and an All method
+// that collects every remaining result.
+//
+//	it := api.DNSRecordsIter(ctx, zoneID, cloudflare.DNSRecord{Type: "CNAME"}, cloudflare.PaginationOptions{})
+//	for it.Next() {
+//		record := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// A Paginator is not safe for concurrent use.
+type Paginator struct {
+	ctx      context.Context
+	fetch    pageFetcher
+	perPage  int
+	nextPage int
+	more     bool
+
+	results reflect.Value
+	index   int
+	info    ResultInfo
+	err     error
+}
+
+// newPaginator returns a Paginator that starts at pageOpts.Page (or the
+// first page) and requests pageOpts.PerPage results per page, if set.
+func newPaginator(ctx context.Context, pageOpts PaginationOptions, fetch pageFetcher) *Paginator {
+	page := pageOpts.Page
+	if page < 1 {
+		page = 1
+	}
+	return &Paginator{
+		ctx:      ctx,
+		fetch:    fetch,
+		perPage:  pageOpts.PerPage,
+		nextPage: page,
+		more:     true,
+		index:    -1,
+	}
+}
+
+// Next advances to the next result, fetching the next page if the current
+// one is exhausted. It returns false when there are no more results or an
+// error occurred; check Err to tell the two apart.
+func (p *Paginator) Next() bool {
+	for p.err == nil {
+		if p.results.IsValid() && p.index+1 < p.results.Len() {
+			p.index++
+			return true
+		}
+		if !p.more {
+			return false
+		}
+		p.fetchNext()
+	}
+	return false
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *Paginator) Err() error {
+	return p.err
+}
+
+// ResultInfo returns the pagination metadata of the most recently fetched
+// page.
+func (p *Paginator) ResultInfo() ResultInfo {
+	return p.info
+}
+
+// value returns the current result.
+func (p *Paginator) value() interface{} {
+	if !p.results.IsValid() || p.index < 0 || p.index >= p.results.Len() {
+		return nil
+	}
+	return p.results.Index(p.index).Interface()
+}
+
+// fetchNext fetches the next page and makes it current.
+func (p *Paginator) fetchNext() {
+	results, info, err := p.fetch(p.ctx, PaginationOptions{Page: p.nextPage, PerPage: p.perPage})
+	if err != nil {
+		p.err = err
+		return
+	}
+	p.results = reflect.ValueOf(results)
+	p.index = -1
+	p.info = info
+	p.more = hasMorePages(p.nextPage, p.results.Len(), info)
+	p.nextPage++
+}
+
+// collect appends every remaining result to the slice dst points to. If the
+// endpoint reports how many pages there are, up to concurrency of the
+// remaining pages are fetched at once; results are appended in page order
+// either way.
+func (p *Paginator) collect(concurrency int, dst interface{}) error {
+	out := reflect.ValueOf(dst).Elem()
+
+	if p.err == nil && !p.results.IsValid() {
+		p.fetchNext()
+	}
+	if p.err != nil {
+		return p.err
+	}
+
+	out.Set(reflect.AppendSlice(out, p.results.Slice(p.index+1, p.results.Len())))
+	p.index = p.results.Len() - 1
+
+	last := totalPages(p.info)
+	if concurrency > 1 && p.more && last >= p.nextPage {
+		pages, err := p.fetchPages(p.nextPage, last, concurrency)
+		if err != nil {
+			p.err = err
+			return err
+		}
+		for _, page := range pages {
+			out.Set(reflect.AppendSlice(out, page))
+		}
+		p.nextPage = last + 1
+		p.more = false
+		return nil
+	}
+
+	for p.Next() {
+		out.Set(reflect.Append(out, p.results.Index(p.index)))
+	}
+	return p.err
+}
+
+// fetchPages fetches pages first to last (inclusive) using up to concurrency
+// goroutines. The first error cancels the outstanding requests and is
+// returned.
+func (p *Paginator) fetchPages(first, last, concurrency int) ([]reflect.Value, error) {
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	pages := make([]reflect.Value, last-first+1)
+	pageNumbers := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for w := 0; w < concurrency && w < len(pages); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pageNumbers {
+				results, _, err := p.fetch(ctx, PaginationOptions{Page: page, PerPage: p.perPage})
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = errors.Wrapf(err, "failed to fetch page %d", page)
+						cancel()
+					})
+					continue
+				}
+				pages[page-first] = reflect.ValueOf(results)
+			}
+		}()
+	}
+
+feed:
+	for page := first; page <= last; page++ {
+		select {
+		case pageNumbers <- page:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(pageNumbers)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := p.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// totalPages returns the number of pages the endpoint reported, or 0 if it
+// cannot be determined.
+func totalPages(info ResultInfo) int {
+	if info.TotalPages > 0 {
+		return info.TotalPages
+	}
+	if info.Total > 0 && info.PerPage > 0 {
+		return (info.Total + info.PerPage - 1) / info.PerPage
+	}
+	return 0
+}
+
+// hasMorePages reports whether there are pages after page, which held n
+// results. Not every endpoint returns total_pages, so when it is missing a
+// full page is taken to mean there may be another.
+func hasMorePages(page, n int, info ResultInfo) bool {
+	if n == 0 {
+		return false
+	}
+	if total := totalPages(info); total > 0 {
+		return page < total
+	}
+	return info.PerPage > 0 && n >= info.PerPage
+}
+
+// paginationQuery returns the query string selecting the page described by
+// pageOpts, or "" if it leaves both to the API's defaults.
+func paginationQuery(pageOpts PaginationOptions) string {
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
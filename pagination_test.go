@@ -0,0 +1,175 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePages returns a pageFetcher over the integers 1..total split into
+// pages of perPage. If withTotals is false, total_pages and total_count are
+// omitted from the ResultInfo, as some endpoints do.
+func fakePages(total, perPage int, withTotals bool) (pageFetcher, *[]int) {
+	var mu sync.Mutex
+	var requested []int
+	fetch := func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		mu.Lock()
+		requested = append(requested, pageOpts.Page)
+		mu.Unlock()
+
+		var results []int
+		for i := (pageOpts.Page-1)*perPage + 1; i <= total && len(results) < perPage; i++ {
+			results = append(results, i)
+		}
+		info := ResultInfo{Page: pageOpts.Page, PerPage: perPage, Count: len(results)}
+		if withTotals {
+			info.Total = total
+			info.TotalPages = (total + perPage - 1) / perPage
+		}
+		return results, info, nil
+	}
+	return fetch, &requested
+}
+
+func TestPaginator_Next(t *testing.T) {
+	for _, withTotals := range []bool{true, false} {
+		fetch, requested := fakePages(7, 3, withTotals)
+		p := newPaginator(context.Background(), PaginationOptions{}, fetch)
+
+		var got []int
+		for p.Next() {
+			got = append(got, p.value().(int))
+		}
+		assert.NoError(t, p.Err())
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, got)
+		assert.Equal(t, []int{1, 2, 3}, *requested)
+	}
+}
+
+func TestPaginator_NextStopsAfterFullLastPageWithoutTotals(t *testing.T) {
+	fetch, requested := fakePages(6, 3, false)
+	p := newPaginator(context.Background(), PaginationOptions{}, fetch)
+
+	n := 0
+	for p.Next() {
+		n++
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, 6, n)
+	// the third page comes back empty, which ends the iteration
+	assert.Equal(t, []int{1, 2, 3}, *requested)
+}
+
+func TestPaginator_StartPage(t *testing.T) {
+	fetch, _ := fakePages(7, 3, true)
+	p := newPaginator(context.Background(), PaginationOptions{Page: 2}, fetch)
+
+	var got []int
+	for p.Next() {
+		got = append(got, p.value().(int))
+	}
+	assert.Equal(t, []int{4, 5, 6, 7}, got)
+}
+
+func TestPaginator_CollectConcurrentlyPreservesOrder(t *testing.T) {
+	fetch, requested := fakePages(95, 10, true)
+	p := newPaginator(context.Background(), PaginationOptions{}, fetch)
+
+	var got []int
+	assert.NoError(t, p.collect(4, &got))
+	assert.Len(t, got, 95)
+	for i, v := range got {
+		assert.Equal(t, i+1, v)
+	}
+	assert.Len(t, *requested, 10)
+	assert.False(t, p.Next())
+}
+
+func TestPaginator_CollectAfterNext(t *testing.T) {
+	fetch, _ := fakePages(10, 4, true)
+	p := newPaginator(context.Background(), PaginationOptions{}, fetch)
+
+	assert.True(t, p.Next())
+	assert.True(t, p.Next())
+
+	var rest []int
+	assert.NoError(t, p.collect(3, &rest))
+	assert.Equal(t, []int{3, 4, 5, 6, 7, 8, 9, 10}, rest)
+}
+
+func TestPaginator_Error(t *testing.T) {
+	fetch, _ := fakePages(100, 10, true)
+	failing := func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		if pageOpts.Page == 5 {
+			return nil, ResultInfo{}, errors.New("boom")
+		}
+		return fetch(ctx, pageOpts)
+	}
+
+	p := newPaginator(context.Background(), PaginationOptions{}, failing)
+	n := 0
+	for p.Next() {
+		n++
+	}
+	assert.Equal(t, 40, n)
+	assert.EqualError(t, p.Err(), "boom")
+
+	p = newPaginator(context.Background(), PaginationOptions{}, failing)
+	var got []int
+	err := p.collect(3, &got)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestDNSRecordsIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET", "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "CNAME", r.URL.Query().Get("type"))
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "record-%[1]d-a", "type": "CNAME"},
+				{"id": "record-%[1]d-b", "type": "CNAME"}
+			],
+			"result_info": {
+				"page": %[1]d,
+				"per_page": 2,
+				"count": 2,
+				"total_count": 6,
+				"total_pages": 3
+			}
+		}`, page)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", handler)
+
+	it := client.DNSRecordsIter(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "CNAME"}, PaginationOptions{PerPage: 2})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"record-1-a", "record-1-b", "record-2-a", "record-2-b", "record-3-a", "record-3-b"}, ids)
+	assert.Equal(t, 3, it.ResultInfo().Page)
+
+	records, err := client.DNSRecordsIter(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "CNAME"}, PaginationOptions{PerPage: 2}).All(3)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 6) {
+		assert.Equal(t, "record-3-b", records[5].ID)
+	}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"time"
@@ -49,13 +50,18 @@ type railgunsResponse struct {
 //
 // API reference: https://api.cloudflare.com/#railgun-create-railgun
 func (api *API) CreateRailgun(name string) (Railgun, error) {
+	return api.CreateRailgunContext(context.TODO(), name)
+}
+
+// CreateRailgunContext is like CreateRailgun but takes a context.
+func (api *API) CreateRailgunContext(ctx context.Context, name string) (Railgun, error) {
 	uri := api.userBaseURL("") + "/railguns"
 	params := struct {
 		Name string `json:"name"`
 	}{
 		Name: name,
 	}
-	res, err := api.makeRequest("POST", uri, params)
+	res, err := api.makeRequestContext(ctx, "POST", uri, params)
 	if err != nil {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -70,12 +76,17 @@ func (api *API) CreateRailgun(name string) (Railgun, error) {
 //
 // API reference: https://api.cloudflare.com/#railgun-list-railguns
 func (api *API) ListRailguns(options RailgunListOptions) ([]Railgun, error) {
+	return api.ListRailgunsContext(context.TODO(), options)
+}
+
+// ListRailgunsContext is like ListRailguns but takes a context.
+func (api *API) ListRailgunsContext(ctx context.Context, options RailgunListOptions) ([]Railgun, error) {
 	v := url.Values{}
 	if options.Direction != "" {
 		v.Set("direction", options.Direction)
 	}
 	uri := api.userBaseURL("") + "/railguns" + "?" + v.Encode()
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -90,8 +101,13 @@ func (api *API) ListRailguns(options RailgunListOptions) ([]Railgun, error) {
 //
 // API reference: https://api.cloudflare.com/#railgun-railgun-details
 func (api *API) RailgunDetails(railgunID string) (Railgun, error) {
+	return api.RailgunDetailsContext(context.TODO(), railgunID)
+}
+
+// RailgunDetailsContext is like RailgunDetails but takes a context.
+func (api *API) RailgunDetailsContext(ctx context.Context, railgunID string) (Railgun, error) {
 	uri := api.userBaseURL("") + "/railguns/" + railgunID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -106,8 +122,13 @@ func (api *API) RailgunDetails(railgunID string) (Railgun, error) {
 //
 // API reference: https://api.cloudflare.com/#railgun-get-zones-connected-to-a-railgun
 func (api *API) RailgunZones(railgunID string) ([]Zone, error) {
+	return api.RailgunZonesContext(context.TODO(), railgunID)
+}
+
+// RailgunZonesContext is like RailgunZones but takes a context.
+func (api *API) RailgunZonesContext(ctx context.Context, railgunID string) ([]Zone, error) {
 	uri := api.userBaseURL("") + "/railguns/" + railgunID + "/zones"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -121,14 +142,14 @@ func (api *API) RailgunZones(railgunID string) ([]Zone, error) {
 // enableRailgun enables (true) or disables (false) a Railgun for all zones connected to it.
 //
 // API reference: https://api.cloudflare.com/#railgun-enable-or-disable-a-railgun
-func (api *API) enableRailgun(railgunID string, enable bool) (Railgun, error) {
+func (api *API) enableRailgun(ctx context.Context, railgunID string, enable bool) (Railgun, error) {
 	uri := api.userBaseURL("") + "/railguns/" + railgunID
 	params := struct {
 		Enabled bool `json:"enabled"`
 	}{
 		Enabled: enable,
 	}
-	res, err := api.makeRequest("PATCH", uri, params)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, params)
 	if err != nil {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -143,22 +164,37 @@ func (api *API) enableRailgun(railgunID string, enable bool) (Railgun, error) {
 //
 // API reference: https://api.cloudflare.com/#railgun-enable-or-disable-a-railgun
 func (api *API) EnableRailgun(railgunID string) (Railgun, error) {
-	return api.enableRailgun(railgunID, true)
+	return api.EnableRailgunContext(context.TODO(), railgunID)
+}
+
+// EnableRailgunContext is like EnableRailgun but takes a context.
+func (api *API) EnableRailgunContext(ctx context.Context, railgunID string) (Railgun, error) {
+	return api.enableRailgun(ctx, railgunID, true)
 }
 
 // DisableRailgun enables a Railgun for all zones connected to it.
 //
 // API reference: https://api.cloudflare.com/#railgun-enable-or-disable-a-railgun
 func (api *API) DisableRailgun(railgunID string) (Railgun, error) {
-	return api.enableRailgun(railgunID, false)
+	return api.DisableRailgunContext(context.TODO(), railgunID)
+}
+
+// DisableRailgunContext is like DisableRailgun but takes a context.
+func (api *API) DisableRailgunContext(ctx context.Context, railgunID string) (Railgun, error) {
+	return api.enableRailgun(ctx, railgunID, false)
 }
 
 // DeleteRailgun disables and deletes a Railgun.
 //
 // API reference: https://api.cloudflare.com/#railgun-delete-railgun
 func (api *API) DeleteRailgun(railgunID string) error {
+	return api.DeleteRailgunContext(context.TODO(), railgunID)
+}
+
+// DeleteRailgunContext is like DeleteRailgun but takes a context.
+func (api *API) DeleteRailgunContext(ctx context.Context, railgunID string) error {
 	uri := api.userBaseURL("") + "/railguns/" + railgunID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	return nil
@@ -217,8 +253,13 @@ type railgunDiagnosisResponse struct {
 //
 // API reference: https://api.cloudflare.com/#railguns-for-a-zone-get-available-railguns
 func (api *API) ZoneRailguns(zoneID string) ([]ZoneRailgun, error) {
+	return api.ZoneRailgunsContext(context.TODO(), zoneID)
+}
+
+// ZoneRailgunsContext is like ZoneRailguns but takes a context.
+func (api *API) ZoneRailgunsContext(ctx context.Context, zoneID string) ([]ZoneRailgun, error) {
 	uri := "/zones/" + zoneID + "/railguns"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -233,8 +274,13 @@ func (api *API) ZoneRailguns(zoneID string) ([]ZoneRailgun, error) {
 //
 // API reference: https://api.cloudflare.com/#railguns-for-a-zone-get-railgun-details
 func (api *API) ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error) {
+	return api.ZoneRailgunDetailsContext(context.TODO(), zoneID, railgunID)
+}
+
+// ZoneRailgunDetailsContext is like ZoneRailgunDetails but takes a context.
+func (api *API) ZoneRailgunDetailsContext(ctx context.Context, zoneID, railgunID string) (ZoneRailgun, error) {
 	uri := "/zones/" + zoneID + "/railguns/" + railgunID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -249,8 +295,13 @@ func (api *API) ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error
 //
 // API reference: https://api.cloudflare.com/#railgun-connections-for-a-zone-test-railgun-connection
 func (api *API) TestRailgunConnection(zoneID, railgunID string) (RailgunDiagnosis, error) {
+	return api.TestRailgunConnectionContext(context.TODO(), zoneID, railgunID)
+}
+
+// TestRailgunConnectionContext is like TestRailgunConnection but takes a context.
+func (api *API) TestRailgunConnectionContext(ctx context.Context, zoneID, railgunID string) (RailgunDiagnosis, error) {
 	uri := "/zones/" + zoneID + "/railguns/" + railgunID + "/diagnose"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return RailgunDiagnosis{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -264,14 +315,14 @@ func (api *API) TestRailgunConnection(zoneID, railgunID string) (RailgunDiagnosi
 // connectZoneRailgun connects (true) or disconnects (false) a Railgun for a given zone.
 //
 // API reference: https://api.cloudflare.com/#railguns-for-a-zone-connect-or-disconnect-a-railgun
-func (api *API) connectZoneRailgun(zoneID, railgunID string, connect bool) (ZoneRailgun, error) {
+func (api *API) connectZoneRailgun(ctx context.Context, zoneID, railgunID string, connect bool) (ZoneRailgun, error) {
 	uri := "/zones/" + zoneID + "/railguns/" + railgunID
 	params := struct {
 		Connected bool `json:"connected"`
 	}{
 		Connected: connect,
 	}
-	res, err := api.makeRequest("PATCH", uri, params)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, params)
 	if err != nil {
 		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -286,12 +337,22 @@ func (api *API) connectZoneRailgun(zoneID, railgunID string, connect bool) (Zone
 //
 // API reference: https://api.cloudflare.com/#railguns-for-a-zone-connect-or-disconnect-a-railgun
 func (api *API) ConnectZoneRailgun(zoneID, railgunID string) (ZoneRailgun, error) {
-	return api.connectZoneRailgun(zoneID, railgunID, true)
+	return api.ConnectZoneRailgunContext(context.TODO(), zoneID, railgunID)
+}
+
+// ConnectZoneRailgunContext is like ConnectZoneRailgun but takes a context.
+func (api *API) ConnectZoneRailgunContext(ctx context.Context, zoneID, railgunID string) (ZoneRailgun, error) {
+	return api.connectZoneRailgun(ctx, zoneID, railgunID, true)
 }
 
 // DisconnectZoneRailgun disconnects a Railgun for a given zone.
 //
 // API reference: https://api.cloudflare.com/#railguns-for-a-zone-connect-or-disconnect-a-railgun
 func (api *API) DisconnectZoneRailgun(zoneID, railgunID string) (ZoneRailgun, error) {
-	return api.connectZoneRailgun(zoneID, railgunID, false)
+	return api.DisconnectZoneRailgunContext(context.TODO(), zoneID, railgunID)
+}
+
+// DisconnectZoneRailgunContext is like DisconnectZoneRailgun but takes a context.
+func (api *API) DisconnectZoneRailgunContext(ctx context.Context, zoneID, railgunID string) (ZoneRailgun, error) {
+	return api.connectZoneRailgun(ctx, zoneID, railgunID, false)
 }
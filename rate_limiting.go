@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -88,8 +89,13 @@ type rateLimitListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-create-a-ratelimit
 func (api *API) CreateRateLimit(zoneID string, limit RateLimit) (RateLimit, error) {
+	return api.CreateRateLimitContext(context.TODO(), zoneID, limit)
+}
+
+// CreateRateLimitContext is like CreateRateLimit but takes a context.
+func (api *API) CreateRateLimitContext(ctx context.Context, zoneID string, limit RateLimit) (RateLimit, error) {
 	uri := "/zones/" + zoneID + "/rate_limits"
-	res, err := api.makeRequest("POST", uri, limit)
+	res, err := api.makeRequestContext(ctx, "POST", uri, limit)
 	if err != nil {
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -104,6 +110,11 @@ func (api *API) CreateRateLimit(zoneID string, limit RateLimit) (RateLimit, erro
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
 func (api *API) ListRateLimits(zoneID string, pageOpts PaginationOptions) ([]RateLimit, ResultInfo, error) {
+	return api.ListRateLimitsContext(context.TODO(), zoneID, pageOpts)
+}
+
+// ListRateLimitsContext is like ListRateLimits but takes a context.
+func (api *API) ListRateLimitsContext(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]RateLimit, ResultInfo, error) {
 	v := url.Values{}
 	if pageOpts.PerPage > 0 {
 		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
@@ -117,7 +128,7 @@ func (api *API) ListRateLimits(zoneID string, pageOpts PaginationOptions) ([]Rat
 		uri = uri + "?" + v.Encode()
 	}
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []RateLimit{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -130,30 +141,56 @@ func (api *API) ListRateLimits(zoneID string, pageOpts PaginationOptions) ([]Rat
 	return r.Result, r.ResultInfo, nil
 }
 
+// RateLimitIterator iterates over rate limits. See Paginator.
+type RateLimitIterator struct {
+	*Paginator
+}
+
+// Value returns the current rate limit.
+func (it *RateLimitIterator) Value() RateLimit {
+	v, _ := it.value().(RateLimit)
+	return v
+}
+
+// All returns every remaining rate limit, fetching up to concurrency pages at a
+// time.
+func (it *RateLimitIterator) All(concurrency int) ([]RateLimit, error) {
+	var limits []RateLimit
+	if err := it.collect(concurrency, &limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// ListRateLimitsIter returns an iterator over the rate limits of a zone.
+func (api *API) ListRateLimitsIter(ctx context.Context, zoneID string, pageOpts PaginationOptions) *RateLimitIterator {
+	return &RateLimitIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.ListRateLimitsContext(ctx, zoneID, pageOpts)
+	})}
+}
+
 // ListAllRateLimits returns all Rate Limits for a zone.
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
 func (api *API) ListAllRateLimits(zoneID string) ([]RateLimit, error) {
+	return api.ListAllRateLimitsContext(context.TODO(), zoneID)
+}
+
+// ListAllRateLimitsContext is like ListAllRateLimits but takes a context.
+func (api *API) ListAllRateLimitsContext(ctx context.Context, zoneID string) ([]RateLimit, error) {
 	pageOpts := PaginationOptions{
 		PerPage: 100, // this is the max page size allowed
 		Page:    1,
 	}
 
-	allRateLimits := make([]RateLimit, 0)
-	for {
-		rateLimits, resultInfo, err := api.ListRateLimits(zoneID, pageOpts)
-		if err != nil {
-			return []RateLimit{}, err
-		}
-		allRateLimits = append(allRateLimits, rateLimits...)
-		// total pages is not returned on this call
-		// if number of records is less than the max, this must be the last page
-		// in case TotalCount % PerPage = 0, the last request will return an empty list
-		if resultInfo.Count < resultInfo.PerPage {
-			break
-		}
-		// continue with the next page
-		pageOpts.Page = pageOpts.Page + 1
+	// total pages is not returned on this call, so the iterator treats a
+	// short page as the last one
+	allRateLimits, err := api.ListRateLimitsIter(ctx, zoneID, pageOpts).All(1)
+	if err != nil {
+		return []RateLimit{}, err
+	}
+	if allRateLimits == nil {
+		allRateLimits = make([]RateLimit, 0)
 	}
 
 	return allRateLimits, nil
@@ -163,8 +200,13 @@ func (api *API) ListAllRateLimits(zoneID string) ([]RateLimit, error) {
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-rate-limit-details
 func (api *API) RateLimit(zoneID, limitID string) (RateLimit, error) {
+	return api.RateLimitContext(context.TODO(), zoneID, limitID)
+}
+
+// RateLimitContext is like RateLimit but takes a context.
+func (api *API) RateLimitContext(ctx context.Context, zoneID, limitID string) (RateLimit, error) {
 	uri := "/zones/" + zoneID + "/rate_limits/" + limitID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -180,8 +222,13 @@ func (api *API) RateLimit(zoneID, limitID string) (RateLimit, error) {
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-update-rate-limit
 func (api *API) UpdateRateLimit(zoneID, limitID string, limit RateLimit) (RateLimit, error) {
+	return api.UpdateRateLimitContext(context.TODO(), zoneID, limitID, limit)
+}
+
+// UpdateRateLimitContext is like UpdateRateLimit but takes a context.
+func (api *API) UpdateRateLimitContext(ctx context.Context, zoneID, limitID string, limit RateLimit) (RateLimit, error) {
 	uri := "/zones/" + zoneID + "/rate_limits/" + limitID
-	res, err := api.makeRequest("PUT", uri, limit)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, limit)
 	if err != nil {
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -196,8 +243,13 @@ func (api *API) UpdateRateLimit(zoneID, limitID string, limit RateLimit) (RateLi
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-delete-rate-limit
 func (api *API) DeleteRateLimit(zoneID, limitID string) error {
+	return api.DeleteRateLimitContext(context.TODO(), zoneID, limitID)
+}
+
+// DeleteRateLimitContext is like DeleteRateLimit but takes a context.
+func (api *API) DeleteRateLimitContext(ctx context.Context, zoneID, limitID string) error {
 	uri := "/zones/" + zoneID + "/rate_limits/" + limitID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -81,9 +82,14 @@ type RegistrarDomainsDetailResponse struct {
 //
 // API reference: https://api.cloudflare.com/#registrar-domains-get-domain
 func (api *API) RegistrarDomain(accountID, domainName string) (RegistrarDomain, error) {
+	return api.RegistrarDomainContext(context.TODO(), accountID, domainName)
+}
+
+// RegistrarDomainContext is like RegistrarDomain but takes a context.
+func (api *API) RegistrarDomainContext(ctx context.Context, accountID, domainName string) (RegistrarDomain, error) {
 	uri := fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domainName)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -101,9 +107,14 @@ func (api *API) RegistrarDomain(accountID, domainName string) (RegistrarDomain,
 //
 // API reference: https://api.cloudflare.com/#registrar-domains-list-domains
 func (api *API) RegistrarDomains(accountID string) ([]RegistrarDomain, error) {
+	return api.RegistrarDomainsContext(context.TODO(), accountID)
+}
+
+// RegistrarDomainsContext is like RegistrarDomains but takes a context.
+func (api *API) RegistrarDomainsContext(ctx context.Context, accountID string) ([]RegistrarDomain, error) {
 	uri := "/accounts/" + accountID + "/registrar/domains"
 
-	res, err := api.makeRequest("POST", uri, nil)
+	res, err := api.makeRequestContext(ctx, "POST", uri, nil)
 	if err != nil {
 		return []RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -121,9 +132,14 @@ func (api *API) RegistrarDomains(accountID string) ([]RegistrarDomain, error) {
 //
 // API reference: https://api.cloudflare.com/#registrar-domains-transfer-domain
 func (api *API) TransferRegistrarDomain(accountID, domainName string) ([]RegistrarDomain, error) {
+	return api.TransferRegistrarDomainContext(context.TODO(), accountID, domainName)
+}
+
+// TransferRegistrarDomainContext is like TransferRegistrarDomain but takes a context.
+func (api *API) TransferRegistrarDomainContext(ctx context.Context, accountID, domainName string) ([]RegistrarDomain, error) {
 	uri := fmt.Sprintf("/accounts/%s/registrar/domains/%s/transfer", accountID, domainName)
 
-	res, err := api.makeRequest("POST", uri, nil)
+	res, err := api.makeRequestContext(ctx, "POST", uri, nil)
 	if err != nil {
 		return []RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -140,9 +156,14 @@ func (api *API) TransferRegistrarDomain(accountID, domainName string) ([]Registr
 //
 // API reference: https://api.cloudflare.com/#registrar-domains-cancel-transfer
 func (api *API) CancelRegistrarDomainTransfer(accountID, domainName string) ([]RegistrarDomain, error) {
+	return api.CancelRegistrarDomainTransferContext(context.TODO(), accountID, domainName)
+}
+
+// CancelRegistrarDomainTransferContext is like CancelRegistrarDomainTransfer but takes a context.
+func (api *API) CancelRegistrarDomainTransferContext(ctx context.Context, accountID, domainName string) ([]RegistrarDomain, error) {
 	uri := fmt.Sprintf("/accounts/%s/registrar/domains/%s/cancel_transfer", accountID, domainName)
 
-	res, err := api.makeRequest("POST", uri, nil)
+	res, err := api.makeRequestContext(ctx, "POST", uri, nil)
 	if err != nil {
 		return []RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -159,9 +180,14 @@ func (api *API) CancelRegistrarDomainTransfer(accountID, domainName string) ([]R
 //
 // API reference: https://api.cloudflare.com/#registrar-domains-update-domain
 func (api *API) UpdateRegistrarDomain(accountID, domainName string, domainConfiguration RegistrarDomainConfiguration) (RegistrarDomain, error) {
+	return api.UpdateRegistrarDomainContext(context.TODO(), accountID, domainName, domainConfiguration)
+}
+
+// UpdateRegistrarDomainContext is like UpdateRegistrarDomain but takes a context.
+func (api *API) UpdateRegistrarDomainContext(ctx context.Context, accountID, domainName string, domainConfiguration RegistrarDomainConfiguration) (RegistrarDomain, error) {
 	uri := fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domainName)
 
-	res, err := api.makeRequest("PUT", uri, domainConfiguration)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, domainConfiguration)
 	if err != nil {
 		return RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -0,0 +1,194 @@
+package cloudflare
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Backoff computes how long to wait before retrying a request.
+type Backoff interface {
+	// Delay returns the duration to wait before the given retry attempt,
+	// which starts at 1 for the first retry. resp is the response to the
+	// previous attempt, or nil if no response was received.
+	Delay(attempt int, resp *http.Response) time.Duration
+}
+
+// BackoffFunc is an adapter to allow the use of ordinary functions as a
+// Backoff.
+type BackoffFunc func(attempt int, resp *http.Response) time.Duration
+
+// Delay calls f(attempt, resp).
+func (f BackoffFunc) Delay(attempt int, resp *http.Response) time.Duration {
+	return f(attempt, resp)
+}
+
+// RetryDecider decides whether a request should be retried.
+type RetryDecider interface {
+	// ShouldRetry is called after every attempt with the request method and
+	// either the response (whose body has already been read) or the error
+	// that prevented one from being received.
+	ShouldRetry(method string, resp *http.Response, err error) bool
+}
+
+// RetryDeciderFunc is an adapter to allow the use of ordinary functions as a
+// RetryDecider.
+type RetryDeciderFunc func(method string, resp *http.Response, err error) bool
+
+// ShouldRetry calls f(method, resp, err).
+func (f RetryDeciderFunc) ShouldRetry(method string, resp *http.Response, err error) bool {
+	return f(method, resp, err)
+}
+
+// ExponentialBackoff doubles the delay on each attempt, starting at Min and
+// capped at Max.
+type ExponentialBackoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Delay implements Backoff.
+func (b ExponentialBackoff) Delay(attempt int, resp *http.Response) time.Duration {
+	if attempt < 1 {
+		return 0
+	}
+	d := float64(b.Min) * math.Pow(2, float64(attempt-1))
+	if d > float64(b.Max) {
+		return b.Max
+	}
+	return time.Duration(d)
+}
+
+// FullJitterBackoff picks a random delay between zero and the delay
+// ExponentialBackoff would use. Spreading retries out this way stops many
+// clients that failed at the same time from retrying at the same time.
+//
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+type FullJitterBackoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Delay implements Backoff.
+func (b FullJitterBackoff) Delay(attempt int, resp *http.Response) time.Duration {
+	ceiling := ExponentialBackoff(b).Delay(attempt, resp)
+	if ceiling <= 0 {
+		return 0
+	}
+	return jitter(ceiling)
+}
+
+// RetryAfterBackoff waits for as long as the Retry-After header of the
+// previous response asks, falling back to another Backoff when the header is
+// absent or unparseable.
+type RetryAfterBackoff struct {
+	// Fallback is used when the previous response has no usable
+	// Retry-After header. A nil Fallback means no delay.
+	Fallback Backoff
+	// Max caps the delay taken from Retry-After. Zero means no cap; the
+	// request context can still be used to bound the total time spent.
+	Max time.Duration
+}
+
+// Delay implements Backoff.
+func (b RetryAfterBackoff) Delay(attempt int, resp *http.Response) time.Duration {
+	if d, ok := parseRetryAfter(resp); ok {
+		if b.Max > 0 && d > b.Max {
+			return b.Max
+		}
+		return d
+	}
+	if b.Fallback == nil {
+		return 0
+	}
+	return b.Fallback.Delay(attempt, resp)
+}
+
+// DefaultRetryDecider retries rate limited (HTTP 429) requests, which the API
+// has not acted upon, regardless of method. Server errors and network
+// failures are only retried for idempotent methods, since a POST or PATCH may
+// have been applied before the failure.
+type DefaultRetryDecider struct {
+	// RetryNonIdempotent also retries POST and PATCH requests on server
+	// errors and network failures.
+	RetryNonIdempotent bool
+}
+
+// ShouldRetry implements RetryDecider.
+func (d DefaultRetryDecider) ShouldRetry(method string, resp *http.Response, err error) bool {
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+		return d.RetryNonIdempotent || isIdempotent(method)
+	}
+	return false
+}
+
+// isIdempotent reports whether repeating a request with the given method has
+// the same effect as making it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter reads the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// jitter returns a random duration in [0, d].
+func jitter(d time.Duration) time.Duration {
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+	return time.Duration(jitterRand.Int63n(int64(d) + 1))
+}
+
+type retryPolicyContextKey struct{}
+
+// ContextWithRetryPolicy returns a copy of ctx that makes requests using it
+// follow policy instead of the client's retry policy. Use it with the
+// Context variants of the API methods to override retries for a single call.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// retryPolicyFromContext returns the RetryPolicy set by
+// ContextWithRetryPolicy, if any.
+func retryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy)
+	return policy, ok
+}
@@ -0,0 +1,137 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff{Min: time.Second, Max: 5 * time.Second}
+	assert.Equal(t, time.Duration(0), b.Delay(0, nil))
+	assert.Equal(t, time.Second, b.Delay(1, nil))
+	assert.Equal(t, 2*time.Second, b.Delay(2, nil))
+	assert.Equal(t, 4*time.Second, b.Delay(3, nil))
+	assert.Equal(t, 5*time.Second, b.Delay(4, nil))
+	assert.Equal(t, 5*time.Second, b.Delay(100, nil))
+}
+
+func TestFullJitterBackoff(t *testing.T) {
+	b := FullJitterBackoff{Min: time.Second, Max: 5 * time.Second}
+	for i := 0; i < 100; i++ {
+		d := b.Delay(3, nil)
+		assert.True(t, d >= 0 && d <= 4*time.Second, "delay %s out of range", d)
+	}
+	assert.Equal(t, time.Duration(0), FullJitterBackoff{}.Delay(1, nil))
+}
+
+func TestRetryAfterBackoff(t *testing.T) {
+	fallback := BackoffFunc(func(attempt int, resp *http.Response) time.Duration {
+		return time.Duration(attempt) * time.Millisecond
+	})
+	b := RetryAfterBackoff{Fallback: fallback, Max: time.Minute}
+
+	resp := &http.Response{Header: make(http.Header)}
+	assert.Equal(t, 2*time.Millisecond, b.Delay(2, nil))
+	assert.Equal(t, 2*time.Millisecond, b.Delay(2, resp))
+
+	resp.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, b.Delay(2, resp))
+
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, time.Minute, b.Delay(2, resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), b.Delay(2, resp))
+
+	resp.Header.Set("Retry-After", "soon")
+	assert.Equal(t, 2*time.Millisecond, b.Delay(2, resp))
+}
+
+func TestDefaultRetryDecider(t *testing.T) {
+	d := DefaultRetryDecider{}
+	status := func(code int) *http.Response { return &http.Response{StatusCode: code} }
+
+	assert.True(t, d.ShouldRetry("POST", status(http.StatusTooManyRequests), nil))
+	assert.True(t, d.ShouldRetry("GET", status(http.StatusBadGateway), nil))
+	assert.True(t, d.ShouldRetry("DELETE", nil, fmt.Errorf("connection reset")))
+	assert.False(t, d.ShouldRetry("POST", status(http.StatusBadGateway), nil))
+	assert.False(t, d.ShouldRetry("PATCH", nil, fmt.Errorf("connection reset")))
+	assert.False(t, d.ShouldRetry("GET", status(http.StatusOK), nil))
+	assert.False(t, d.ShouldRetry("GET", status(http.StatusNotFound), nil))
+
+	d.RetryNonIdempotent = true
+	assert.True(t, d.ShouldRetry("POST", status(http.StatusBadGateway), nil))
+}
+
+func TestClient_RetryHonoursRetryAfter(t *testing.T) {
+	var delays []time.Duration
+	backoff := BackoffFunc(func(attempt int, resp *http.Response) time.Duration {
+		d := RetryAfterBackoff{}.Delay(attempt, resp)
+		delays = append(delays, d)
+		return 0
+	})
+	setup(UsingRetryPolicy(1, 0, 0), UsingRetryBackoff(backoff))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+		if requestsReceived == 1 {
+			w.Header().Set("Retry-After", "12")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.UserDetails()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requestsReceived)
+	assert.Equal(t, []time.Duration{12 * time.Second}, delays)
+}
+
+func TestClient_NonIdempotentRequestNotRetriedOnServerError(t *testing.T) {
+	setup(UsingRetryPolicy(3, 0, 0))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST", "Expected method 'POST', got %s", r.Method)
+		requestsReceived++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "A", Name: "example.com", Content: "198.51.100.4"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, requestsReceived)
+}
+
+func TestClient_ContextRetryPolicyOverride(t *testing.T) {
+	setup(UsingRetryPolicy(0, 0, 0))
+	defer teardown()
+
+	requestsReceived := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.UserDetails()
+	assert.Error(t, err)
+	assert.Equal(t, 1, requestsReceived)
+
+	requestsReceived = 0
+	ctx := ContextWithRetryPolicy(context.Background(), RetryPolicy{
+		MaxRetries: 2,
+		Backoff:    ExponentialBackoff{},
+	})
+	_, err = client.UserDetailsContext(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 3, requestsReceived)
+}
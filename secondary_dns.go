@@ -0,0 +1,591 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TSIG algorithms supported for zone transfers.
+const (
+	TSIGAlgorithmHMACMD5    = "hmac-md5.sig-alg.reg.int."
+	TSIGAlgorithmHMACSHA1   = "hmac-sha1."
+	TSIGAlgorithmHMACSHA256 = "hmac-sha256."
+	TSIGAlgorithmHMACSHA512 = "hmac-sha512."
+)
+
+var tsigAlgorithms = map[string]bool{
+	TSIGAlgorithmHMACMD5:    true,
+	TSIGAlgorithmHMACSHA1:   true,
+	TSIGAlgorithmHMACSHA256: true,
+	TSIGAlgorithmHMACSHA512: true,
+}
+
+// SecondaryDNSPeer is a nameserver that takes part in zone transfers: a
+// primary that Cloudflare transfers secondary zones from, or a secondary
+// that Cloudflare notifies and serves zones to. Peers belong to an account
+// and are referred to by ID from SecondaryDNSZone and OutgoingZoneTransfer.
+type SecondaryDNSPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip,omitempty"`
+	Port       int    `json:"port,omitempty"`
+	IXFREnable bool   `json:"ixfr_enable"`
+	TSIGID     string `json:"tsig_id,omitempty"`
+}
+
+// SecondaryDNSPeerResponse represents the response from the secondary DNS
+// peer endpoints.
+type SecondaryDNSPeerResponse struct {
+	Response
+	Result SecondaryDNSPeer `json:"result"`
+}
+
+// SecondaryDNSPeersResponse represents the response from the list secondary
+// DNS peers endpoint.
+type SecondaryDNSPeersResponse struct {
+	Response
+	Result []SecondaryDNSPeer `json:"result"`
+}
+
+// SecondaryDNSTSIG is a TSIG key used to authenticate zone transfers with a
+// peer. Algo is one of the TSIGAlgorithm constants and Secret is base64
+// encoded.
+type SecondaryDNSTSIG struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+	Algo   string `json:"algo"`
+}
+
+// validate checks the TSIG key locally, so that a bad algorithm or secret is
+// reported before a request is made.
+func (t SecondaryDNSTSIG) validate() error {
+	if t.Name == "" {
+		return errors.New("TSIG key name is required")
+	}
+	if !tsigAlgorithms[t.Algo] {
+		return errors.Errorf("unsupported TSIG algorithm %q", t.Algo)
+	}
+	if _, err := base64.StdEncoding.DecodeString(t.Secret); err != nil || t.Secret == "" {
+		return errors.New("TSIG secret must be base64 encoded")
+	}
+	return nil
+}
+
+// SecondaryDNSTSIGResponse represents the response from the TSIG key
+// endpoints.
+type SecondaryDNSTSIGResponse struct {
+	Response
+	Result SecondaryDNSTSIG `json:"result"`
+}
+
+// SecondaryDNSTSIGsResponse represents the response from the list TSIG keys
+// endpoint.
+type SecondaryDNSTSIGsResponse struct {
+	Response
+	Result []SecondaryDNSTSIG `json:"result"`
+}
+
+// SecondaryDNSZone is the incoming transfer configuration of a secondary
+// zone, one created with the "secondary" zone type. Peers are the IDs of
+// the primaries to transfer from, and AutoRefreshSeconds is how often the
+// primaries' SOA serial is checked when no NOTIFY is received.
+type SecondaryDNSZone struct {
+	ID                 string     `json:"id,omitempty"`
+	Name               string     `json:"name"`
+	Peers              []string   `json:"peers"`
+	AutoRefreshSeconds int        `json:"auto_refresh_seconds"`
+	SOASerial          int        `json:"soa_serial,omitempty"`
+	CreatedTime        *time.Time `json:"created_time,omitempty"`
+	CheckedTime        *time.Time `json:"checked_time,omitempty"`
+	ModifiedTime       *time.Time `json:"modified_time,omitempty"`
+}
+
+// SecondaryDNSZoneResponse represents the response from the secondary zone
+// configuration endpoints.
+type SecondaryDNSZoneResponse struct {
+	Response
+	Result SecondaryDNSZone `json:"result"`
+}
+
+// OutgoingZoneTransfer is the outgoing transfer configuration of a zone for
+// which Cloudflare is the primary. Peers are the IDs of the secondaries that
+// are notified of changes and allowed to transfer the zone.
+type OutgoingZoneTransfer struct {
+	ID                  string     `json:"id,omitempty"`
+	Name                string     `json:"name"`
+	Peers               []string   `json:"peers"`
+	SOASerial           int        `json:"soa_serial,omitempty"`
+	CreatedTime         *time.Time `json:"created_time,omitempty"`
+	CheckedTime         *time.Time `json:"checked_time,omitempty"`
+	LastTransferredTime *time.Time `json:"last_transferred_time,omitempty"`
+}
+
+// OutgoingZoneTransferResponse represents the response from the outgoing
+// zone transfer endpoints.
+type OutgoingZoneTransferResponse struct {
+	Response
+	Result OutgoingZoneTransfer `json:"result"`
+}
+
+// secondaryDNSStatusResponse represents the response from the secondary DNS
+// endpoints that trigger an action and return only a status message.
+type secondaryDNSStatusResponse struct {
+	Response
+	Result string `json:"result"`
+}
+
+// SecondaryDNSPeers returns the zone transfer peers of an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer--list-peers
+func (api *API) SecondaryDNSPeers(accountID string) ([]SecondaryDNSPeer, error) {
+	return api.SecondaryDNSPeersContext(context.TODO(), accountID)
+}
+
+// SecondaryDNSPeersContext is like SecondaryDNSPeers but takes a context.
+func (api *API) SecondaryDNSPeersContext(ctx context.Context, accountID string) ([]SecondaryDNSPeer, error) {
+	if accountID == "" {
+		return []SecondaryDNSPeer{}, errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/peers"
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return []SecondaryDNSPeer{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSPeersResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []SecondaryDNSPeer{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSPeer returns a single zone transfer peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer--peer-details
+func (api *API) SecondaryDNSPeer(accountID, peerID string) (SecondaryDNSPeer, error) {
+	return api.SecondaryDNSPeerContext(context.TODO(), accountID, peerID)
+}
+
+// SecondaryDNSPeerContext is like SecondaryDNSPeer but takes a context.
+func (api *API) SecondaryDNSPeerContext(ctx context.Context, accountID, peerID string) (SecondaryDNSPeer, error) {
+	if accountID == "" {
+		return SecondaryDNSPeer{}, errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/peers/" + peerID
+	return api.secondaryDNSPeerRequest(ctx, "GET", uri, nil)
+}
+
+// CreateSecondaryDNSPeer creates a zone transfer peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer--create-peer
+func (api *API) CreateSecondaryDNSPeer(accountID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	return api.CreateSecondaryDNSPeerContext(context.TODO(), accountID, peer)
+}
+
+// CreateSecondaryDNSPeerContext is like CreateSecondaryDNSPeer but takes a
+// context.
+func (api *API) CreateSecondaryDNSPeerContext(ctx context.Context, accountID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	if accountID == "" {
+		return SecondaryDNSPeer{}, errors.New(errMissingAccountID)
+	}
+	if peer.Name == "" {
+		return SecondaryDNSPeer{}, errors.New("peer name is required")
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/peers"
+	return api.secondaryDNSPeerRequest(ctx, "POST", uri, peer)
+}
+
+// UpdateSecondaryDNSPeer replaces a zone transfer peer. The peer's ID must
+// be set.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer--update-peer
+func (api *API) UpdateSecondaryDNSPeer(accountID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	return api.UpdateSecondaryDNSPeerContext(context.TODO(), accountID, peer)
+}
+
+// UpdateSecondaryDNSPeerContext is like UpdateSecondaryDNSPeer but takes a
+// context.
+func (api *API) UpdateSecondaryDNSPeerContext(ctx context.Context, accountID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	if accountID == "" {
+		return SecondaryDNSPeer{}, errors.New(errMissingAccountID)
+	}
+	if peer.ID == "" {
+		return SecondaryDNSPeer{}, errors.New("peer ID is required")
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/peers/" + peer.ID
+	return api.secondaryDNSPeerRequest(ctx, "PUT", uri, peer)
+}
+
+// DeleteSecondaryDNSPeer deletes a zone transfer peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer--delete-peer
+func (api *API) DeleteSecondaryDNSPeer(accountID, peerID string) error {
+	return api.DeleteSecondaryDNSPeerContext(context.TODO(), accountID, peerID)
+}
+
+// DeleteSecondaryDNSPeerContext is like DeleteSecondaryDNSPeer but takes a
+// context.
+func (api *API) DeleteSecondaryDNSPeerContext(ctx context.Context, accountID, peerID string) error {
+	if accountID == "" {
+		return errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/peers/" + peerID
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+func (api *API) secondaryDNSPeerRequest(ctx context.Context, method, uri string, params interface{}) (SecondaryDNSPeer, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return SecondaryDNSPeer{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSPeerResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return SecondaryDNSPeer{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSTSIGs returns the TSIG keys of an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig--list-tsigs
+func (api *API) SecondaryDNSTSIGs(accountID string) ([]SecondaryDNSTSIG, error) {
+	return api.SecondaryDNSTSIGsContext(context.TODO(), accountID)
+}
+
+// SecondaryDNSTSIGsContext is like SecondaryDNSTSIGs but takes a context.
+func (api *API) SecondaryDNSTSIGsContext(ctx context.Context, accountID string) ([]SecondaryDNSTSIG, error) {
+	if accountID == "" {
+		return []SecondaryDNSTSIG{}, errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs"
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return []SecondaryDNSTSIG{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSTSIGsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []SecondaryDNSTSIG{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSTSIG returns a single TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig--tsig-details
+func (api *API) SecondaryDNSTSIG(accountID, tsigID string) (SecondaryDNSTSIG, error) {
+	return api.SecondaryDNSTSIGContext(context.TODO(), accountID, tsigID)
+}
+
+// SecondaryDNSTSIGContext is like SecondaryDNSTSIG but takes a context.
+func (api *API) SecondaryDNSTSIGContext(ctx context.Context, accountID, tsigID string) (SecondaryDNSTSIG, error) {
+	if accountID == "" {
+		return SecondaryDNSTSIG{}, errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs/" + tsigID
+	return api.secondaryDNSTSIGRequest(ctx, "GET", uri, nil)
+}
+
+// CreateSecondaryDNSTSIG creates a TSIG key. The algorithm and secret are
+// checked before the request is made.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig--create-tsig
+func (api *API) CreateSecondaryDNSTSIG(accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	return api.CreateSecondaryDNSTSIGContext(context.TODO(), accountID, tsig)
+}
+
+// CreateSecondaryDNSTSIGContext is like CreateSecondaryDNSTSIG but takes a
+// context.
+func (api *API) CreateSecondaryDNSTSIGContext(ctx context.Context, accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	if accountID == "" {
+		return SecondaryDNSTSIG{}, errors.New(errMissingAccountID)
+	}
+	if err := tsig.validate(); err != nil {
+		return SecondaryDNSTSIG{}, err
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs"
+	return api.secondaryDNSTSIGRequest(ctx, "POST", uri, tsig)
+}
+
+// UpdateSecondaryDNSTSIG replaces a TSIG key. The key's ID must be set.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig--update-tsig
+func (api *API) UpdateSecondaryDNSTSIG(accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	return api.UpdateSecondaryDNSTSIGContext(context.TODO(), accountID, tsig)
+}
+
+// UpdateSecondaryDNSTSIGContext is like UpdateSecondaryDNSTSIG but takes a
+// context.
+func (api *API) UpdateSecondaryDNSTSIGContext(ctx context.Context, accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	if accountID == "" {
+		return SecondaryDNSTSIG{}, errors.New(errMissingAccountID)
+	}
+	if tsig.ID == "" {
+		return SecondaryDNSTSIG{}, errors.New("TSIG key ID is required")
+	}
+	if err := tsig.validate(); err != nil {
+		return SecondaryDNSTSIG{}, err
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs/" + tsig.ID
+	return api.secondaryDNSTSIGRequest(ctx, "PUT", uri, tsig)
+}
+
+// DeleteSecondaryDNSTSIG deletes a TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig--delete-tsig
+func (api *API) DeleteSecondaryDNSTSIG(accountID, tsigID string) error {
+	return api.DeleteSecondaryDNSTSIGContext(context.TODO(), accountID, tsigID)
+}
+
+// DeleteSecondaryDNSTSIGContext is like DeleteSecondaryDNSTSIG but takes a
+// context.
+func (api *API) DeleteSecondaryDNSTSIGContext(ctx context.Context, accountID, tsigID string) error {
+	if accountID == "" {
+		return errors.New(errMissingAccountID)
+	}
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs/" + tsigID
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+func (api *API) secondaryDNSTSIGRequest(ctx context.Context, method, uri string, params interface{}) (SecondaryDNSTSIG, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSTSIGResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSZoneConfig returns the incoming transfer configuration of a
+// secondary zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-configuration-details
+func (api *API) SecondaryDNSZoneConfig(zoneID string) (SecondaryDNSZone, error) {
+	return api.SecondaryDNSZoneConfigContext(context.TODO(), zoneID)
+}
+
+// SecondaryDNSZoneConfigContext is like SecondaryDNSZoneConfig but takes a
+// context.
+func (api *API) SecondaryDNSZoneConfigContext(ctx context.Context, zoneID string) (SecondaryDNSZone, error) {
+	return api.secondaryDNSZoneRequest(ctx, "GET", zoneID, nil)
+}
+
+// CreateSecondaryDNSZoneConfig configures incoming transfers for a secondary
+// zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-create-secondary-zone-configuration
+func (api *API) CreateSecondaryDNSZoneConfig(zoneID string, config SecondaryDNSZone) (SecondaryDNSZone, error) {
+	return api.CreateSecondaryDNSZoneConfigContext(context.TODO(), zoneID, config)
+}
+
+// CreateSecondaryDNSZoneConfigContext is like CreateSecondaryDNSZoneConfig
+// but takes a context.
+func (api *API) CreateSecondaryDNSZoneConfigContext(ctx context.Context, zoneID string, config SecondaryDNSZone) (SecondaryDNSZone, error) {
+	if len(config.Peers) == 0 {
+		return SecondaryDNSZone{}, errors.New("at least one primary peer is required")
+	}
+	return api.secondaryDNSZoneRequest(ctx, "POST", zoneID, config)
+}
+
+// UpdateSecondaryDNSZoneConfig replaces the incoming transfer configuration
+// of a secondary zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-update-secondary-zone-configuration
+func (api *API) UpdateSecondaryDNSZoneConfig(zoneID string, config SecondaryDNSZone) (SecondaryDNSZone, error) {
+	return api.UpdateSecondaryDNSZoneConfigContext(context.TODO(), zoneID, config)
+}
+
+// UpdateSecondaryDNSZoneConfigContext is like UpdateSecondaryDNSZoneConfig
+// but takes a context.
+func (api *API) UpdateSecondaryDNSZoneConfigContext(ctx context.Context, zoneID string, config SecondaryDNSZone) (SecondaryDNSZone, error) {
+	if len(config.Peers) == 0 {
+		return SecondaryDNSZone{}, errors.New("at least one primary peer is required")
+	}
+	return api.secondaryDNSZoneRequest(ctx, "PUT", zoneID, config)
+}
+
+// DeleteSecondaryDNSZoneConfig removes the incoming transfer configuration
+// of a secondary zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-delete-secondary-zone-configuration
+func (api *API) DeleteSecondaryDNSZoneConfig(zoneID string) error {
+	return api.DeleteSecondaryDNSZoneConfigContext(context.TODO(), zoneID)
+}
+
+// DeleteSecondaryDNSZoneConfigContext is like DeleteSecondaryDNSZoneConfig
+// but takes a context.
+func (api *API) DeleteSecondaryDNSZoneConfigContext(ctx context.Context, zoneID string) error {
+	uri := "/zones/" + zoneID + "/secondary_dns/incoming"
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+func (api *API) secondaryDNSZoneRequest(ctx context.Context, method, zoneID string, params interface{}) (SecondaryDNSZone, error) {
+	uri := "/zones/" + zoneID + "/secondary_dns/incoming"
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return SecondaryDNSZone{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSZoneResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return SecondaryDNSZone{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ForceSecondaryDNSZoneAXFR starts a full transfer of a secondary zone from
+// its primaries, without waiting for a NOTIFY or the refresh interval.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone--force-axfr
+func (api *API) ForceSecondaryDNSZoneAXFR(zoneID string) error {
+	return api.ForceSecondaryDNSZoneAXFRContext(context.TODO(), zoneID)
+}
+
+// ForceSecondaryDNSZoneAXFRContext is like ForceSecondaryDNSZoneAXFR but
+// takes a context.
+func (api *API) ForceSecondaryDNSZoneAXFRContext(ctx context.Context, zoneID string) error {
+	_, err := api.secondaryDNSStatusRequest(ctx, "/zones/"+zoneID+"/secondary_dns/force_axfr")
+	return err
+}
+
+// OutgoingZoneTransferConfig returns the outgoing transfer configuration of
+// a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--primary-zone-configuration-details
+func (api *API) OutgoingZoneTransferConfig(zoneID string) (OutgoingZoneTransfer, error) {
+	return api.OutgoingZoneTransferConfigContext(context.TODO(), zoneID)
+}
+
+// OutgoingZoneTransferConfigContext is like OutgoingZoneTransferConfig but
+// takes a context.
+func (api *API) OutgoingZoneTransferConfigContext(ctx context.Context, zoneID string) (OutgoingZoneTransfer, error) {
+	return api.outgoingZoneTransferRequest(ctx, "GET", zoneID, nil)
+}
+
+// CreateOutgoingZoneTransferConfig configures outgoing transfers for a zone.
+// Transfers start once they are enabled with EnableOutgoingZoneTransfer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--create-primary-zone-configuration
+func (api *API) CreateOutgoingZoneTransferConfig(zoneID string, config OutgoingZoneTransfer) (OutgoingZoneTransfer, error) {
+	return api.CreateOutgoingZoneTransferConfigContext(context.TODO(), zoneID, config)
+}
+
+// CreateOutgoingZoneTransferConfigContext is like
+// CreateOutgoingZoneTransferConfig but takes a context.
+func (api *API) CreateOutgoingZoneTransferConfigContext(ctx context.Context, zoneID string, config OutgoingZoneTransfer) (OutgoingZoneTransfer, error) {
+	return api.outgoingZoneTransferRequest(ctx, "POST", zoneID, config)
+}
+
+// UpdateOutgoingZoneTransferConfig replaces the outgoing transfer
+// configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--update-primary-zone-configuration
+func (api *API) UpdateOutgoingZoneTransferConfig(zoneID string, config OutgoingZoneTransfer) (OutgoingZoneTransfer, error) {
+	return api.UpdateOutgoingZoneTransferConfigContext(context.TODO(), zoneID, config)
+}
+
+// UpdateOutgoingZoneTransferConfigContext is like
+// UpdateOutgoingZoneTransferConfig but takes a context.
+func (api *API) UpdateOutgoingZoneTransferConfigContext(ctx context.Context, zoneID string, config OutgoingZoneTransfer) (OutgoingZoneTransfer, error) {
+	return api.outgoingZoneTransferRequest(ctx, "PUT", zoneID, config)
+}
+
+// DeleteOutgoingZoneTransferConfig removes the outgoing transfer
+// configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--delete-primary-zone-configuration
+func (api *API) DeleteOutgoingZoneTransferConfig(zoneID string) error {
+	return api.DeleteOutgoingZoneTransferConfigContext(context.TODO(), zoneID)
+}
+
+// DeleteOutgoingZoneTransferConfigContext is like
+// DeleteOutgoingZoneTransferConfig but takes a context.
+func (api *API) DeleteOutgoingZoneTransferConfigContext(ctx context.Context, zoneID string) error {
+	uri := "/zones/" + zoneID + "/secondary_dns/outgoing"
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+func (api *API) outgoingZoneTransferRequest(ctx context.Context, method, zoneID string, params interface{}) (OutgoingZoneTransfer, error) {
+	uri := "/zones/" + zoneID + "/secondary_dns/outgoing"
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return OutgoingZoneTransfer{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r OutgoingZoneTransferResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return OutgoingZoneTransfer{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// EnableOutgoingZoneTransfer enables outgoing transfers of a zone and
+// returns the resulting status.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--enable-outgoing-zone-transfers
+func (api *API) EnableOutgoingZoneTransfer(zoneID string) (string, error) {
+	return api.EnableOutgoingZoneTransferContext(context.TODO(), zoneID)
+}
+
+// EnableOutgoingZoneTransferContext is like EnableOutgoingZoneTransfer but
+// takes a context.
+func (api *API) EnableOutgoingZoneTransferContext(ctx context.Context, zoneID string) (string, error) {
+	return api.secondaryDNSStatusRequest(ctx, "/zones/"+zoneID+"/secondary_dns/outgoing/enable")
+}
+
+// DisableOutgoingZoneTransfer disables outgoing transfers of a zone and
+// returns the resulting status.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--disable-outgoing-zone-transfers
+func (api *API) DisableOutgoingZoneTransfer(zoneID string) (string, error) {
+	return api.DisableOutgoingZoneTransferContext(context.TODO(), zoneID)
+}
+
+// DisableOutgoingZoneTransferContext is like DisableOutgoingZoneTransfer but
+// takes a context.
+func (api *API) DisableOutgoingZoneTransferContext(ctx context.Context, zoneID string) (string, error) {
+	return api.secondaryDNSStatusRequest(ctx, "/zones/"+zoneID+"/secondary_dns/outgoing/disable")
+}
+
+// ForceOutgoingZoneTransferNotify sends a NOTIFY to the zone's secondary
+// peers, prompting them to transfer the zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone--force-dns-notify
+func (api *API) ForceOutgoingZoneTransferNotify(zoneID string) error {
+	return api.ForceOutgoingZoneTransferNotifyContext(context.TODO(), zoneID)
+}
+
+// ForceOutgoingZoneTransferNotifyContext is like
+// ForceOutgoingZoneTransferNotify but takes a context.
+func (api *API) ForceOutgoingZoneTransferNotifyContext(ctx context.Context, zoneID string) error {
+	_, err := api.secondaryDNSStatusRequest(ctx, "/zones/"+zoneID+"/secondary_dns/outgoing/force_notify")
+	return err
+}
+
+func (api *API) secondaryDNSStatusRequest(ctx context.Context, uri string) (string, error) {
+	res, err := api.makeRequestContext(ctx, "POST", uri, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r secondaryDNSStatusResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
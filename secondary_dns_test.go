@@ -0,0 +1,143 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSecondaryDNSPeer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/secondary_dns/peers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "hidden-primary", "ip": "192.0.2.53", "port": 53, "ixfr_enable": false, "tsig_id": "69cd1e104af3e6ed3cb344f263fd0d5a"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": {
+				"id": "23ff594956f20c2a721606e94745a8aa",
+				"name": "hidden-primary",
+				"ip": "192.0.2.53",
+				"port": 53,
+				"ixfr_enable": false,
+				"tsig_id": "69cd1e104af3e6ed3cb344f263fd0d5a"
+			}
+		}`)
+	})
+
+	peer := SecondaryDNSPeer{Name: "hidden-primary", IP: "192.0.2.53", Port: 53, TSIGID: "69cd1e104af3e6ed3cb344f263fd0d5a"}
+	actual, err := client.CreateSecondaryDNSPeer("01a7362d577a6c3019a474fd6f485823", peer)
+	if assert.NoError(t, err) {
+		peer.ID = "23ff594956f20c2a721606e94745a8aa"
+		assert.Equal(t, peer, actual)
+	}
+
+	_, err = client.CreateSecondaryDNSPeer("", peer)
+	assert.EqualError(t, err, errMissingAccountID)
+}
+
+func TestCreateSecondaryDNSTSIG(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/secondary_dns/tsigs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var tsig SecondaryDNSTSIG
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&tsig))
+		tsig.ID = "69cd1e104af3e6ed3cb344f263fd0d5a"
+		w.Header().Set("content-type", "application/json")
+		json.NewEncoder(w).Encode(SecondaryDNSTSIGResponse{Response: Response{Success: true}, Result: tsig})
+	})
+
+	tsig := SecondaryDNSTSIG{Name: "tsig.example.com.", Secret: "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c", Algo: TSIGAlgorithmHMACSHA512}
+	actual, err := client.CreateSecondaryDNSTSIG("01a7362d577a6c3019a474fd6f485823", tsig)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "69cd1e104af3e6ed3cb344f263fd0d5a", actual.ID)
+		assert.Equal(t, TSIGAlgorithmHMACSHA512, actual.Algo)
+	}
+
+	bad := tsig
+	bad.Algo = "hmac-sha384."
+	_, err = client.CreateSecondaryDNSTSIG("01a7362d577a6c3019a474fd6f485823", bad)
+	assert.EqualError(t, err, `unsupported TSIG algorithm "hmac-sha384."`)
+
+	bad = tsig
+	bad.Secret = "not base64!"
+	_, err = client.CreateSecondaryDNSTSIG("01a7362d577a6c3019a474fd6f485823", bad)
+	assert.EqualError(t, err, "TSIG secret must be base64 encoded")
+}
+
+func TestSecondaryDNSZoneConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/secondary_dns/incoming", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": {
+				"id": "023e105f4ecef8ad9ca31a8372d0c353",
+				"name": "www.example.com.",
+				"peers": ["23ff594956f20c2a721606e94745a8aa", "00920f38ce07c2e2f4df50b1f61d4194"],
+				"auto_refresh_seconds": 86400,
+				"soa_serial": 2019102400,
+				"created_time": "2019-10-24T17:09:42.883908+01:00",
+				"checked_time": "2019-10-24T17:09:42.883908+01:00",
+				"modified_time": "2019-10-24T17:09:42.883908+01:00"
+			}
+		}`)
+	})
+
+	when := time.Date(2019, 10, 24, 16, 9, 42, 883908000, time.UTC)
+	actual, err := client.SecondaryDNSZoneConfig("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "www.example.com.", actual.Name)
+		assert.Equal(t, []string{"23ff594956f20c2a721606e94745a8aa", "00920f38ce07c2e2f4df50b1f61d4194"}, actual.Peers)
+		assert.Equal(t, 86400, actual.AutoRefreshSeconds)
+		assert.Equal(t, 2019102400, actual.SOASerial)
+		if assert.NotNil(t, actual.CheckedTime) {
+			assert.True(t, when.Equal(*actual.CheckedTime))
+		}
+	}
+
+	_, err = client.CreateSecondaryDNSZoneConfig("023e105f4ecef8ad9ca31a8372d0c353", SecondaryDNSZone{Name: "www.example.com."})
+	assert.EqualError(t, err, "at least one primary peer is required")
+}
+
+func TestForceSecondaryDNSZoneAXFR(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/secondary_dns/force_axfr", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "OK"}`)
+	})
+
+	assert.NoError(t, client.ForceSecondaryDNSZoneAXFR("023e105f4ecef8ad9ca31a8372d0c353"))
+}
+
+func TestEnableOutgoingZoneTransfer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/secondary_dns/outgoing/enable", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "Enabled"}`)
+	})
+
+	status, err := client.EnableOutgoingZoneTransfer("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Enabled", status)
+	}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -56,9 +57,14 @@ type SpectrumApplicationsDetailResponse struct {
 //
 // API reference: https://developers.cloudflare.com/spectrum/api-reference/#list-spectrum-applications
 func (api *API) SpectrumApplications(zoneID string) ([]SpectrumApplication, error) {
+	return api.SpectrumApplicationsContext(context.TODO(), zoneID)
+}
+
+// SpectrumApplicationsContext is like SpectrumApplications but takes a context.
+func (api *API) SpectrumApplicationsContext(ctx context.Context, zoneID string) ([]SpectrumApplication, error) {
 	uri := "/zones/" + zoneID + "/spectrum/apps"
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -76,13 +82,18 @@ func (api *API) SpectrumApplications(zoneID string) ([]SpectrumApplication, erro
 //
 // API reference: https://developers.cloudflare.com/spectrum/api-reference/#list-spectrum-applications
 func (api *API) SpectrumApplication(zoneID string, applicationID string) (SpectrumApplication, error) {
+	return api.SpectrumApplicationContext(context.TODO(), zoneID, applicationID)
+}
+
+// SpectrumApplicationContext is like SpectrumApplication but takes a context.
+func (api *API) SpectrumApplicationContext(ctx context.Context, zoneID string, applicationID string) (SpectrumApplication, error) {
 	uri := fmt.Sprintf(
 		"/zones/%s/spectrum/apps/%s",
 		zoneID,
 		applicationID,
 	)
 
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -100,9 +111,14 @@ func (api *API) SpectrumApplication(zoneID string, applicationID string) (Spectr
 //
 // API reference: https://developers.cloudflare.com/spectrum/api-reference/#create-a-spectrum-application
 func (api *API) CreateSpectrumApplication(zoneID string, appDetails SpectrumApplication) (SpectrumApplication, error) {
+	return api.CreateSpectrumApplicationContext(context.TODO(), zoneID, appDetails)
+}
+
+// CreateSpectrumApplicationContext is like CreateSpectrumApplication but takes a context.
+func (api *API) CreateSpectrumApplicationContext(ctx context.Context, zoneID string, appDetails SpectrumApplication) (SpectrumApplication, error) {
 	uri := "/zones/" + zoneID + "/spectrum/apps"
 
-	res, err := api.makeRequest("POST", uri, appDetails)
+	res, err := api.makeRequestContext(ctx, "POST", uri, appDetails)
 	if err != nil {
 		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -120,13 +136,18 @@ func (api *API) CreateSpectrumApplication(zoneID string, appDetails SpectrumAppl
 //
 // API reference: https://developers.cloudflare.com/spectrum/api-reference/#update-a-spectrum-application
 func (api *API) UpdateSpectrumApplication(zoneID, appID string, appDetails SpectrumApplication) (SpectrumApplication, error) {
+	return api.UpdateSpectrumApplicationContext(context.TODO(), zoneID, appID, appDetails)
+}
+
+// UpdateSpectrumApplicationContext is like UpdateSpectrumApplication but takes a context.
+func (api *API) UpdateSpectrumApplicationContext(ctx context.Context, zoneID, appID string, appDetails SpectrumApplication) (SpectrumApplication, error) {
 	uri := fmt.Sprintf(
 		"/zones/%s/spectrum/apps/%s",
 		zoneID,
 		appID,
 	)
 
-	res, err := api.makeRequest("PUT", uri, appDetails)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, appDetails)
 	if err != nil {
 		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -144,13 +165,18 @@ func (api *API) UpdateSpectrumApplication(zoneID, appID string, appDetails Spect
 //
 // API reference: https://developers.cloudflare.com/spectrum/api-reference/#delete-a-spectrum-application
 func (api *API) DeleteSpectrumApplication(zoneID string, applicationID string) error {
+	return api.DeleteSpectrumApplicationContext(context.TODO(), zoneID, applicationID)
+}
+
+// DeleteSpectrumApplicationContext is like DeleteSpectrumApplication but takes a context.
+func (api *API) DeleteSpectrumApplicationContext(ctx context.Context, zoneID string, applicationID string) error {
 	uri := fmt.Sprintf(
 		"/zones/%s/spectrum/apps/%s",
 		zoneID,
 		applicationID,
 	)
 
-	_, err := api.makeRequest("DELETE", uri, nil)
+	_, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
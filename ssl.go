@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -63,8 +64,13 @@ type ZoneCustomSSLPriority struct {
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-create-ssl-configuration
 func (api *API) CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
+	return api.CreateSSLContext(context.TODO(), zoneID, options)
+}
+
+// CreateSSLContext is like CreateSSL but takes a context.
+func (api *API) CreateSSLContext(ctx context.Context, zoneID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates"
-	res, err := api.makeRequest("POST", uri, options)
+	res, err := api.makeRequestContext(ctx, "POST", uri, options)
 	if err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -79,8 +85,13 @@ func (api *API) CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCust
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-list-ssl-configurations
 func (api *API) ListSSL(zoneID string) ([]ZoneCustomSSL, error) {
+	return api.ListSSLContext(context.TODO(), zoneID)
+}
+
+// ListSSLContext is like ListSSL but takes a context.
+func (api *API) ListSSLContext(ctx context.Context, zoneID string) ([]ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -95,8 +106,13 @@ func (api *API) ListSSL(zoneID string) ([]ZoneCustomSSL, error) {
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-ssl-configuration-details
 func (api *API) SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error) {
+	return api.SSLDetailsContext(context.TODO(), zoneID, certificateID)
+}
+
+// SSLDetailsContext is like SSLDetails but takes a context.
+func (api *API) SSLDetailsContext(ctx context.Context, zoneID, certificateID string) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -111,8 +127,13 @@ func (api *API) SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error)
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-update-ssl-configuration
 func (api *API) UpdateSSL(zoneID, certificateID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
+	return api.UpdateSSLContext(context.TODO(), zoneID, certificateID, options)
+}
+
+// UpdateSSLContext is like UpdateSSL but takes a context.
+func (api *API) UpdateSSLContext(ctx context.Context, zoneID, certificateID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	res, err := api.makeRequest("PATCH", uri, options)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, options)
 	if err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -128,13 +149,18 @@ func (api *API) UpdateSSL(zoneID, certificateID string, options ZoneCustomSSLOpt
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-re-prioritize-ssl-certificates
 func (api *API) ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]ZoneCustomSSL, error) {
+	return api.ReprioritizeSSLContext(context.TODO(), zoneID, p)
+}
+
+// ReprioritizeSSLContext is like ReprioritizeSSL but takes a context.
+func (api *API) ReprioritizeSSLContext(ctx context.Context, zoneID string, p []ZoneCustomSSLPriority) ([]ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates/prioritize"
 	params := struct {
 		Certificates []ZoneCustomSSLPriority `json:"certificates"`
 	}{
 		Certificates: p,
 	}
-	res, err := api.makeRequest("PUT", uri, params)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, params)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -149,8 +175,13 @@ func (api *API) ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]Zon
 //
 // API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-delete-an-ssl-certificate
 func (api *API) DeleteSSL(zoneID, certificateID string) error {
+	return api.DeleteSSLContext(context.TODO(), zoneID, certificateID)
+}
+
+// DeleteSSLContext is like DeleteSSL but takes a context.
+func (api *API) DeleteSSLContext(ctx context.Context, zoneID, certificateID string) error {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+	if _, err := api.makeRequestContext(ctx, "DELETE", uri, nil); err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	return nil
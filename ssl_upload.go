@@ -0,0 +1,283 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The bundle methods of a custom SSL certificate, which decide the chain
+// of intermediate certificates served with it.
+const (
+	// SSLBundleUbiquitous serves the chain that is trusted by the most
+	// clients.
+	SSLBundleUbiquitous = "ubiquitous"
+	// SSLBundleOptimal serves the shortest chain with the newest
+	// intermediates.
+	SSLBundleOptimal = "optimal"
+	// SSLBundleForce serves the chain exactly as uploaded.
+	SSLBundleForce = "force"
+)
+
+// SSLCertificateInfo describes a certificate and private key checked by
+// CheckSSLCertificate.
+type SSLCertificateInfo struct {
+	// Chain holds the certificates as given, the zone's certificate first.
+	Chain []*x509.Certificate
+	// Hosts are the names and addresses the certificate is valid for.
+	Hosts     []string
+	Issuer    string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// KeyType is the type and size of the key, such as "RSA 2048" or
+	// "ECDSA P-256".
+	KeyType string
+	// Trusted reports whether the chain leads to a trusted root, so that
+	// the API can build a chain for it.
+	Trusted bool
+	// BundleMethod is the bundle method that suits the certificate:
+	// SSLBundleUbiquitous if it is trusted, or SSLBundleForce otherwise, as
+	// only the uploaded chain can be served.
+	BundleMethod string
+}
+
+// SSLCertificateCheckOptions configures CheckSSLCertificate.
+type SSLCertificateCheckOptions struct {
+	// Roots are the root certificates to trust. If nil, the system's are
+	// used.
+	Roots *x509.CertPool
+}
+
+// CheckSSLCertificate parses a certificate, followed by any intermediate
+// certificates, and its private key, all in PEM format, as they would be
+// given to CreateSSL. It checks that the key matches the certificate, that
+// each certificate in the chain is signed by the next, that the key is RSA
+// of at least 2048 bits or ECDSA, and that the certificate has not expired.
+//
+// A chain that does not lead to a trusted root is not an error, but is
+// reported by the Trusted field.
+func CheckSSLCertificate(certificate, privateKey string, opts SSLCertificateCheckOptions) (SSLCertificateInfo, error) {
+	var info SSLCertificateInfo
+
+	chain, err := parseCertificatePEM([]byte(certificate))
+	if err != nil {
+		return info, err
+	}
+	leaf := chain[0]
+	info.Chain = chain
+
+	key, err := parsePrivateKeyPEM([]byte(privateKey))
+	if err != nil {
+		return info, err
+	}
+	if !publicKeysEqual(leaf.PublicKey, key.Public()) {
+		return info, errors.New("private key does not match the certificate")
+	}
+	if info.KeyType, err = sslKeyType(key.Public()); err != nil {
+		return info, err
+	}
+
+	for i := 0; i+1 < len(chain); i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return info, errors.Errorf("certificate %d in the chain (%s) is not signed by the one after it (%s)",
+				i+1, chain[i].Subject.CommonName, chain[i+1].Subject.CommonName)
+		}
+	}
+
+	info.Hosts = append(info.Hosts, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		info.Hosts = append(info.Hosts, ip.String())
+	}
+	if len(info.Hosts) == 0 && leaf.Subject.CommonName != "" {
+		info.Hosts = []string{leaf.Subject.CommonName}
+	}
+	if len(info.Hosts) == 0 {
+		return info, errors.New("certificate names no hosts")
+	}
+	info.Issuer = leaf.Issuer.CommonName
+	info.NotBefore, info.NotAfter = leaf.NotBefore, leaf.NotAfter
+	if time.Now().After(leaf.NotAfter) {
+		return info, errors.Errorf("certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         opts.Roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	info.Trusted = err == nil
+	info.BundleMethod = SSLBundleForce
+	if info.Trusted {
+		info.BundleMethod = SSLBundleUbiquitous
+	}
+	return info, nil
+}
+
+// parseCertificatePEM parses the certificates in b, in order.
+func parseCertificatePEM(b []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, errors.Errorf("unexpected %s in certificate", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse certificate %d", len(chain)+1)
+		}
+		chain = append(chain, cert)
+	}
+	if len(bytes.TrimSpace(b)) > 0 {
+		return nil, errors.New("certificate has data that is not PEM")
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no certificate found in PEM")
+	}
+	return chain, nil
+}
+
+// parsePrivateKeyPEM parses a PKCS #1, PKCS #8 or EC private key.
+func parsePrivateKeyPEM(b []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no private key found in PEM")
+	}
+	if x509.IsEncryptedPEMBlock(block) {
+		return nil, errors.New("private key is encrypted")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("unexpected %s in private key", block.Type)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse private key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	ab, err := x509.MarshalPKIXPublicKey(a)
+	if err != nil {
+		return false
+	}
+	bb, err := x509.MarshalPKIXPublicKey(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ab, bb)
+}
+
+// sslKeyType describes key, if it is a type the API accepts.
+func sslKeyType(key crypto.PublicKey) (string, error) {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		bits := key.N.BitLen()
+		if bits < 2048 {
+			return "", errors.Errorf("RSA key of %d bits is too short; at least 2048 are needed", bits)
+		}
+		return fmt.Sprintf("RSA %d", bits), nil
+	case *ecdsa.PublicKey:
+		return "ECDSA " + key.Curve.Params().Name, nil
+	}
+	return "", errors.Errorf("unsupported key type %T", key)
+}
+
+// SSLUploadOptions configures UploadSSL.
+type SSLUploadOptions struct {
+	// Replace is the ID of the custom certificate to replace. If empty, the
+	// certificate for exactly the same hosts is replaced, if there is one;
+	// otherwise the certificate is added.
+	Replace string
+	// Roots are passed on to CheckSSLCertificate.
+	Roots *x509.CertPool
+}
+
+// UploadSSL checks a custom certificate and key with CheckSSLCertificate
+// and, if they pass, uploads them to a zone, replacing the certificate they
+// renew. If options.BundleMethod is empty, the bundle method that suits the
+// certificate is used.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-create-ssl-configuration
+func (api *API) UploadSSL(zoneID string, options ZoneCustomSSLOptions, opts SSLUploadOptions) (ZoneCustomSSL, SSLCertificateInfo, error) {
+	return api.UploadSSLContext(context.TODO(), zoneID, options, opts)
+}
+
+// UploadSSLContext is like UploadSSL but takes a context.
+func (api *API) UploadSSLContext(ctx context.Context, zoneID string, options ZoneCustomSSLOptions, opts SSLUploadOptions) (ZoneCustomSSL, SSLCertificateInfo, error) {
+	info, err := CheckSSLCertificate(options.Certificate, options.PrivateKey, SSLCertificateCheckOptions{Roots: opts.Roots})
+	if err != nil {
+		return ZoneCustomSSL{}, info, err
+	}
+	if options.BundleMethod == "" {
+		options.BundleMethod = info.BundleMethod
+	}
+
+	replace := opts.Replace
+	if replace == "" {
+		existing, err := api.ListSSLContext(ctx, zoneID)
+		if err != nil {
+			return ZoneCustomSSL{}, info, err
+		}
+		want := normalizeSSLHosts(info.Hosts)
+		for _, cert := range existing {
+			if normalizeSSLHosts(cert.Hosts) == want {
+				replace = cert.ID
+				break
+			}
+		}
+	}
+
+	var cert ZoneCustomSSL
+	if replace != "" {
+		cert, err = api.UpdateSSLContext(ctx, zoneID, replace, options)
+	} else {
+		cert, err = api.CreateSSLContext(ctx, zoneID, options)
+	}
+	return cert, info, err
+}
+
+// normalizeSSLHosts returns hosts as a string that is the same for any
+// other list of the same hosts.
+func normalizeSSLHosts(hosts []string) string {
+	normalized := make([]string, len(hosts))
+	for i, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			host = ip.String()
+		}
+		normalized[i] = strings.ToLower(strings.TrimSuffix(host, "."))
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ",")
+}
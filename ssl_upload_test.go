@@ -0,0 +1,243 @@
+package cloudflare
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCert is a certificate and key generated for a test.
+type testCert struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func (c testCert) certPEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}))
+}
+
+func (c testCert) keyPEM(t *testing.T) string {
+	switch key := c.key.(type) {
+	case *rsa.PrivateKey:
+		return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	case *ecdsa.PrivateKey:
+		b, err := x509.MarshalECPrivateKey(key)
+		assert.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}))
+	}
+	t.Fatalf("unexpected key type %T", c.key)
+	return ""
+}
+
+// newTestCert creates a certificate from template with a new P-256 key,
+// signed by parent, or self-signed if parent is nil.
+func newTestCert(t *testing.T, template *x509.Certificate, parent *testCert) testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return newTestCertWithKey(t, template, key, parent)
+}
+
+func newTestCertWithKey(t *testing.T, template *x509.Certificate, key crypto.Signer, parent *testCert) testCert {
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template.SerialNumber = serial
+	if template.NotBefore.IsZero() {
+		template.NotBefore = time.Now().Add(-time.Hour)
+	}
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().AddDate(0, 0, 90)
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentKey)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	cert, err := x509.ParseCertificate(der)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return testCert{cert: cert, key: key}
+}
+
+// newTestChain creates a root, an intermediate and a certificate for
+// example.com and www.example.com.
+func newTestChain(t *testing.T) (root, intermediate, leaf testCert) {
+	ca := func(name string) *x509.Certificate {
+		return &x509.Certificate{
+			Subject:               pkix.Name{CommonName: name},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+	}
+	root = newTestCert(t, ca("Test Root"), nil)
+	intermediate = newTestCert(t, ca("Test Intermediate"), &root)
+	leaf = newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "example.com"},
+		DNSNames:    []string{"example.com", "www.example.com"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, &intermediate)
+	return root, intermediate, leaf
+}
+
+func TestCheckSSLCertificate(t *testing.T) {
+	root, intermediate, leaf := newTestChain(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	info, err := CheckSSLCertificate(leaf.certPEM()+intermediate.certPEM(), leaf.keyPEM(t), SSLCertificateCheckOptions{Roots: roots})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"example.com", "www.example.com"}, info.Hosts)
+		assert.Equal(t, "Test Intermediate", info.Issuer)
+		assert.Equal(t, "ECDSA P-256", info.KeyType)
+		assert.Equal(t, leaf.cert.NotAfter, info.NotAfter)
+		assert.Len(t, info.Chain, 2)
+		assert.True(t, info.Trusted)
+		assert.Equal(t, SSLBundleUbiquitous, info.BundleMethod)
+	}
+
+	// Without the intermediate, or a trusted root, only the chain as
+	// uploaded can be served.
+	info, err = CheckSSLCertificate(leaf.certPEM(), leaf.keyPEM(t), SSLCertificateCheckOptions{Roots: roots})
+	if assert.NoError(t, err) {
+		assert.False(t, info.Trusted)
+		assert.Equal(t, SSLBundleForce, info.BundleMethod)
+	}
+	info, err = CheckSSLCertificate(leaf.certPEM()+intermediate.certPEM(), leaf.keyPEM(t), SSLCertificateCheckOptions{Roots: x509.NewCertPool()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, SSLBundleForce, info.BundleMethod)
+	}
+}
+
+func TestCheckSSLCertificate_Errors(t *testing.T) {
+	root, intermediate, leaf := newTestChain(t)
+
+	_, err := CheckSSLCertificate("", leaf.keyPEM(t), SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "no certificate found in PEM")
+
+	_, err = CheckSSLCertificate(leaf.certPEM(), "", SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "no private key found in PEM")
+
+	_, err = CheckSSLCertificate(leaf.certPEM(), intermediate.keyPEM(t), SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "private key does not match the certificate")
+
+	_, err = CheckSSLCertificate(leaf.certPEM()+root.certPEM(), leaf.keyPEM(t), SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "certificate 1 in the chain (example.com) is not signed by the one after it (Test Root)")
+
+	expired := newTestCert(t, &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "example.com"},
+		DNSNames:  []string{"example.com"},
+		NotBefore: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, &intermediate)
+	_, err = CheckSSLCertificate(expired.certPEM(), expired.keyPEM(t), SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "certificate expired on 2019-01-01T00:00:00Z")
+
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if !assert.NoError(t, err) {
+		return
+	}
+	weak := newTestCertWithKey(t, &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com"},
+	}, weakKey, nil)
+	_, err = CheckSSLCertificate(weak.certPEM(), weak.keyPEM(t), SSLCertificateCheckOptions{})
+	assert.EqualError(t, err, "RSA key of 1024 bits is too short; at least 2048 are needed")
+}
+
+func TestUploadSSL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	root, intermediate, leaf := newTestChain(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	var requests []string
+	var body ZoneCustomSSLOptions
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("content-type", "application/json")
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+				{"id": "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60", "hosts": ["other.example.com"]},
+				{"id": "7e7b8deba8538af625850b7b2530034c", "hosts": ["WWW.example.com", "example.com"]}
+			]}`)
+			return
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "7e7b8deba8538af625850b7b2530034c", "hosts": ["example.com", "www.example.com"]}}`)
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates", handler)
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates/7e7b8deba8538af625850b7b2530034c", handler)
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates/2458ce5a-0c35-4c7f-82c7-8e9487d3ff60", handler)
+
+	options := ZoneCustomSSLOptions{
+		Certificate: leaf.certPEM() + intermediate.certPEM(),
+		PrivateKey:  leaf.keyPEM(t),
+	}
+	cert, info, err := client.UploadSSL("023e105f4ecef8ad9ca31a8372d0c353", options, SSLUploadOptions{Roots: roots})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "7e7b8deba8538af625850b7b2530034c", cert.ID)
+		assert.True(t, info.Trusted)
+	}
+	assert.Equal(t, []string{
+		"GET /zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates",
+		"PATCH /zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates/7e7b8deba8538af625850b7b2530034c",
+	}, requests, "the certificate for the same hosts is replaced")
+	assert.Equal(t, SSLBundleUbiquitous, body.BundleMethod)
+
+	requests = nil
+	options.BundleMethod = SSLBundleOptimal
+	_, _, err = client.UploadSSL("023e105f4ecef8ad9ca31a8372d0c353", options, SSLUploadOptions{
+		Replace: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+		Roots:   roots,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PATCH /zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates/2458ce5a-0c35-4c7f-82c7-8e9487d3ff60"}, requests)
+	assert.Equal(t, SSLBundleOptimal, body.BundleMethod)
+
+	// A certificate for new hosts is added.
+	requests = nil
+	other := newTestCert(t, &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "api.example.com"},
+		DNSNames: []string{"api.example.com"},
+	}, &intermediate)
+	_, info, err = client.UploadSSL("023e105f4ecef8ad9ca31a8372d0c353", ZoneCustomSSLOptions{
+		Certificate: other.certPEM(),
+		PrivateKey:  other.keyPEM(t),
+	}, SSLUploadOptions{Roots: roots})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates",
+		"POST /zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates",
+	}, requests)
+	assert.Equal(t, SSLBundleForce, body.BundleMethod, "the chain was not uploaded, so it must be forced")
+	assert.False(t, info.Trusted)
+
+	// Nothing is sent for a certificate that fails the checks.
+	requests = nil
+	_, _, err = client.UploadSSL("023e105f4ecef8ad9ca31a8372d0c353", ZoneCustomSSLOptions{
+		Certificate: leaf.certPEM(),
+		PrivateKey:  other.keyPEM(t),
+	}, SSLUploadOptions{})
+	assert.EqualError(t, err, "private key does not match the certificate")
+	assert.Empty(t, requests)
+}
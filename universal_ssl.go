@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/pkg/errors"
@@ -42,8 +43,13 @@ type universalSSLVerificationResponse struct {
 //
 // API reference: https://api.cloudflare.com/#universal-ssl-settings-for-a-zone-universal-ssl-settings-details
 func (api *API) UniversalSSLSettingDetails(zoneID string) (UniversalSSLSetting, error) {
+	return api.UniversalSSLSettingDetailsContext(context.TODO(), zoneID)
+}
+
+// UniversalSSLSettingDetailsContext is like UniversalSSLSettingDetails but takes a context.
+func (api *API) UniversalSSLSettingDetailsContext(ctx context.Context, zoneID string) (UniversalSSLSetting, error) {
 	uri := "/zones/" + zoneID + "/ssl/universal/settings"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return UniversalSSLSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -58,8 +64,13 @@ func (api *API) UniversalSSLSettingDetails(zoneID string) (UniversalSSLSetting,
 //
 // API reference: https://api.cloudflare.com/#universal-ssl-settings-for-a-zone-edit-universal-ssl-settings
 func (api *API) EditUniversalSSLSetting(zoneID string, setting UniversalSSLSetting) (UniversalSSLSetting, error) {
+	return api.EditUniversalSSLSettingContext(context.TODO(), zoneID, setting)
+}
+
+// EditUniversalSSLSettingContext is like EditUniversalSSLSetting but takes a context.
+func (api *API) EditUniversalSSLSettingContext(ctx context.Context, zoneID string, setting UniversalSSLSetting) (UniversalSSLSetting, error) {
 	uri := "/zones/" + zoneID + "/ssl/universal/settings"
-	res, err := api.makeRequest("PATCH", uri, setting)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, setting)
 	if err != nil {
 		return UniversalSSLSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -75,8 +86,13 @@ func (api *API) EditUniversalSSLSetting(zoneID string, setting UniversalSSLSetti
 //
 // API reference: https://api.cloudflare.com/#ssl-verification-ssl-verification-details
 func (api *API) UniversalSSLVerificationDetails(zoneID string) ([]UniversalSSLVerificationDetails, error) {
+	return api.UniversalSSLVerificationDetailsContext(context.TODO(), zoneID)
+}
+
+// UniversalSSLVerificationDetailsContext is like UniversalSSLVerificationDetails but takes a context.
+func (api *API) UniversalSSLVerificationDetailsContext(ctx context.Context, zoneID string) ([]UniversalSSLVerificationDetails, error) {
 	uri := "/zones/" + zoneID + "/ssl/verification"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []UniversalSSLVerificationDetails{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -62,8 +63,13 @@ type UserBillingProfile struct {
 //
 // API reference: https://api.cloudflare.com/#user-user-details
 func (api *API) UserDetails() (User, error) {
+	return api.UserDetailsContext(context.TODO())
+}
+
+// UserDetailsContext is like UserDetails but takes a context.
+func (api *API) UserDetailsContext(ctx context.Context) (User, error) {
 	var r UserResponse
-	res, err := api.makeRequest("GET", "/user", nil)
+	res, err := api.makeRequestContext(ctx, "GET", "/user", nil)
 	if err != nil {
 		return User{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -80,8 +86,13 @@ func (api *API) UserDetails() (User, error) {
 //
 // API reference: https://api.cloudflare.com/#user-update-user
 func (api *API) UpdateUser(user *User) (User, error) {
+	return api.UpdateUserContext(context.TODO(), user)
+}
+
+// UpdateUserContext is like UpdateUser but takes a context.
+func (api *API) UpdateUserContext(ctx context.Context, user *User) (User, error) {
 	var r UserResponse
-	res, err := api.makeRequest("PATCH", "/user", user)
+	res, err := api.makeRequestContext(ctx, "PATCH", "/user", user)
 	if err != nil {
 		return User{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -98,8 +109,13 @@ func (api *API) UpdateUser(user *User) (User, error) {
 //
 // API reference: https://api.cloudflare.com/#user-billing-profile
 func (api *API) UserBillingProfile() (UserBillingProfile, error) {
+	return api.UserBillingProfileContext(context.TODO())
+}
+
+// UserBillingProfileContext is like UserBillingProfile but takes a context.
+func (api *API) UserBillingProfileContext(ctx context.Context) (UserBillingProfile, error) {
 	var r userBillingProfileResponse
-	res, err := api.makeRequest("GET", "/user/billing/profile", nil)
+	res, err := api.makeRequestContext(ctx, "GET", "/user/billing/profile", nil)
 	if err != nil {
 		return UserBillingProfile{}, errors.Wrap(err, errMakeRequestError)
 	}
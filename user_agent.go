@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -41,6 +42,11 @@ type UserAgentRuleListResponse struct {
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-create-a-useragent-rule
 func (api *API) CreateUserAgentRule(zoneID string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
+	return api.CreateUserAgentRuleContext(context.TODO(), zoneID, ld)
+}
+
+// CreateUserAgentRuleContext is like CreateUserAgentRule but takes a context.
+func (api *API) CreateUserAgentRuleContext(ctx context.Context, zoneID string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
 	switch ld.Mode {
 	case "block", "challenge", "js_challenge", "whitelist":
 		break
@@ -49,7 +55,7 @@ func (api *API) CreateUserAgentRule(zoneID string, ld UserAgentRule) (*UserAgent
 	}
 
 	uri := "/zones/" + zoneID + "/firewall/ua_rules"
-	res, err := api.makeRequest("POST", uri, ld)
+	res, err := api.makeRequestContext(ctx, "POST", uri, ld)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -67,8 +73,13 @@ func (api *API) CreateUserAgentRule(zoneID string, ld UserAgentRule) (*UserAgent
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-update-useragent-rule
 func (api *API) UpdateUserAgentRule(zoneID string, id string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
+	return api.UpdateUserAgentRuleContext(context.TODO(), zoneID, id, ld)
+}
+
+// UpdateUserAgentRuleContext is like UpdateUserAgentRule but takes a context.
+func (api *API) UpdateUserAgentRuleContext(ctx context.Context, zoneID string, id string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/ua_rules/" + id
-	res, err := api.makeRequest("PUT", uri, ld)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, ld)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -86,8 +97,13 @@ func (api *API) UpdateUserAgentRule(zoneID string, id string, ld UserAgentRule)
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-delete-useragent-rule
 func (api *API) DeleteUserAgentRule(zoneID string, id string) (*UserAgentRuleResponse, error) {
+	return api.DeleteUserAgentRuleContext(context.TODO(), zoneID, id)
+}
+
+// DeleteUserAgentRuleContext is like DeleteUserAgentRule but takes a context.
+func (api *API) DeleteUserAgentRuleContext(ctx context.Context, zoneID string, id string) (*UserAgentRuleResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/ua_rules/" + id
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -105,8 +121,13 @@ func (api *API) DeleteUserAgentRule(zoneID string, id string) (*UserAgentRuleRes
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-useragent-rule-details
 func (api *API) UserAgentRule(zoneID string, id string) (*UserAgentRuleResponse, error) {
+	return api.UserAgentRuleContext(context.TODO(), zoneID, id)
+}
+
+// UserAgentRuleContext is like UserAgentRule but takes a context.
+func (api *API) UserAgentRuleContext(ctx context.Context, zoneID string, id string) (*UserAgentRuleResponse, error) {
 	uri := "/zones/" + zoneID + "/firewall/ua_rules/" + id
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -124,6 +145,11 @@ func (api *API) UserAgentRule(zoneID string, id string) (*UserAgentRuleResponse,
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-useragent-rules
 func (api *API) ListUserAgentRules(zoneID string, page int) (*UserAgentRuleListResponse, error) {
+	return api.ListUserAgentRulesContext(context.TODO(), zoneID, page)
+}
+
+// ListUserAgentRulesContext is like ListUserAgentRules but takes a context.
+func (api *API) ListUserAgentRulesContext(ctx context.Context, zoneID string, page int) (*UserAgentRuleListResponse, error) {
 	v := url.Values{}
 	if page <= 0 {
 		page = 1
@@ -134,7 +160,7 @@ func (api *API) ListUserAgentRules(zoneID string, page int) (*UserAgentRuleListR
 	query := "?" + v.Encode()
 
 	uri := "/zones/" + zoneID + "/firewall/ua_rules" + query
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -147,3 +173,36 @@ func (api *API) ListUserAgentRules(zoneID string, page int) (*UserAgentRuleListR
 
 	return response, nil
 }
+
+// UserAgentRuleIterator iterates over User-Agent rules. See Paginator.
+type UserAgentRuleIterator struct {
+	*Paginator
+}
+
+// Value returns the current User-Agent rule.
+func (it *UserAgentRuleIterator) Value() UserAgentRule {
+	v, _ := it.value().(UserAgentRule)
+	return v
+}
+
+// All returns every remaining User-Agent rule, fetching up to concurrency pages
+// at a time.
+func (it *UserAgentRuleIterator) All(concurrency int) ([]UserAgentRule, error) {
+	var rules []UserAgentRule
+	if err := it.collect(concurrency, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ListUserAgentRulesIter returns an iterator over the User-Agent rules of a
+// zone.
+func (api *API) ListUserAgentRulesIter(ctx context.Context, zoneID string) *UserAgentRuleIterator {
+	return &UserAgentRuleIterator{newPaginator(ctx, PaginationOptions{}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		r, err := api.ListUserAgentRulesContext(ctx, zoneID, pageOpts.Page)
+		if err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return r.Result, r.ResultInfo, nil
+	})}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strings"
@@ -69,7 +70,12 @@ type VirtualDNSAnalyticsResponse struct {
 //
 // API reference: https://api.cloudflare.com/#virtual-dns-users--create-a-virtual-dns-cluster
 func (api *API) CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error) {
-	res, err := api.makeRequest("POST", "/user/virtual_dns", v)
+	return api.CreateVirtualDNSContext(context.TODO(), v)
+}
+
+// CreateVirtualDNSContext is like CreateVirtualDNS but takes a context.
+func (api *API) CreateVirtualDNSContext(ctx context.Context, v *VirtualDNS) (*VirtualDNS, error) {
+	res, err := api.makeRequestContext(ctx, "POST", "/user/virtual_dns", v)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -87,8 +93,13 @@ func (api *API) CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error) {
 //
 // API reference: https://api.cloudflare.com/#virtual-dns-users--get-a-virtual-dns-cluster
 func (api *API) VirtualDNS(virtualDNSID string) (*VirtualDNS, error) {
+	return api.VirtualDNSContext(context.TODO(), virtualDNSID)
+}
+
+// VirtualDNSContext is like VirtualDNS but takes a context.
+func (api *API) VirtualDNSContext(ctx context.Context, virtualDNSID string) (*VirtualDNS, error) {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -106,7 +117,12 @@ func (api *API) VirtualDNS(virtualDNSID string) (*VirtualDNS, error) {
 //
 // API reference: https://api.cloudflare.com/#virtual-dns-users--get-virtual-dns-clusters
 func (api *API) ListVirtualDNS() ([]*VirtualDNS, error) {
-	res, err := api.makeRequest("GET", "/user/virtual_dns", nil)
+	return api.ListVirtualDNSContext(context.TODO())
+}
+
+// ListVirtualDNSContext is like ListVirtualDNS but takes a context.
+func (api *API) ListVirtualDNSContext(ctx context.Context) ([]*VirtualDNS, error) {
+	res, err := api.makeRequestContext(ctx, "GET", "/user/virtual_dns", nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -124,8 +140,13 @@ func (api *API) ListVirtualDNS() ([]*VirtualDNS, error) {
 //
 // API reference: https://api.cloudflare.com/#virtual-dns-users--modify-a-virtual-dns-cluster
 func (api *API) UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error {
+	return api.UpdateVirtualDNSContext(context.TODO(), virtualDNSID, vv)
+}
+
+// UpdateVirtualDNSContext is like UpdateVirtualDNS but takes a context.
+func (api *API) UpdateVirtualDNSContext(ctx context.Context, virtualDNSID string, vv VirtualDNS) error {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("PUT", uri, vv)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, vv)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -144,8 +165,13 @@ func (api *API) UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error {
 //
 // API reference: https://api.cloudflare.com/#virtual-dns-users--delete-a-virtual-dns-cluster
 func (api *API) DeleteVirtualDNS(virtualDNSID string) error {
+	return api.DeleteVirtualDNSContext(context.TODO(), virtualDNSID)
+}
+
+// DeleteVirtualDNSContext is like DeleteVirtualDNS but takes a context.
+func (api *API) DeleteVirtualDNSContext(ctx context.Context, virtualDNSID string) error {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -176,8 +202,13 @@ func (o VirtualDNSUserAnalyticsOptions) encode() string {
 
 // VirtualDNSUserAnalytics retrieves analytics report for a specified dimension and time range
 func (api *API) VirtualDNSUserAnalytics(virtualDNSID string, o VirtualDNSUserAnalyticsOptions) (VirtualDNSAnalytics, error) {
+	return api.VirtualDNSUserAnalyticsContext(context.TODO(), virtualDNSID, o)
+}
+
+// VirtualDNSUserAnalyticsContext is like VirtualDNSUserAnalytics but takes a context.
+func (api *API) VirtualDNSUserAnalyticsContext(ctx context.Context, virtualDNSID string, o VirtualDNSUserAnalyticsOptions) (VirtualDNSAnalytics, error) {
 	uri := "/user/virtual_dns/" + virtualDNSID + "/dns_analytics/report?" + o.encode()
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return VirtualDNSAnalytics{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/pkg/errors"
@@ -101,35 +102,76 @@ type WAFRuleOptions struct {
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-packages-list-firewall-packages
 func (api *API) ListWAFPackages(zoneID string) ([]WAFPackage, error) {
+	return api.ListWAFPackagesContext(context.TODO(), zoneID)
+}
+
+// ListWAFPackagesContext is like ListWAFPackages but takes a context.
+func (api *API) ListWAFPackagesContext(ctx context.Context, zoneID string) ([]WAFPackage, error) {
+	packages, err := api.ListWAFPackagesIter(ctx, zoneID, PaginationOptions{}).All(api.paginationConcurrency)
+	if err != nil {
+		return []WAFPackage{}, err
+	}
+	return packages, nil
+}
+
+// listWAFPackages fetches a page of the WAF packages for the given zone.
+func (api *API) listWAFPackages(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]WAFPackage, ResultInfo, error) {
 	var p WAFPackagesResponse
-	var packages []WAFPackage
-	var res []byte
-	var err error
-	uri := "/zones/" + zoneID + "/firewall/waf/packages"
-	res, err = api.makeRequest("GET", uri, nil)
+	uri := "/zones/" + zoneID + "/firewall/waf/packages" + paginationQuery(pageOpts)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return []WAFPackage{}, errors.Wrap(err, errMakeRequestError)
+		return []WAFPackage{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 	err = json.Unmarshal(res, &p)
 	if err != nil {
-		return []WAFPackage{}, errors.Wrap(err, errUnmarshalError)
+		return []WAFPackage{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 	if !p.Success {
 		// TODO: Provide an actual error message instead of always returning nil
-		return []WAFPackage{}, err
+		return []WAFPackage{}, ResultInfo{}, err
 	}
-	for pi := range p.Result {
-		packages = append(packages, p.Result[pi])
+	return p.Result, p.ResultInfo, nil
+}
+
+// WAFPackageIterator iterates over WAF packages. See Paginator.
+type WAFPackageIterator struct {
+	*Paginator
+}
+
+// Value returns the current WAF package.
+func (it *WAFPackageIterator) Value() WAFPackage {
+	v, _ := it.value().(WAFPackage)
+	return v
+}
+
+// All returns every remaining WAF package, fetching up to concurrency pages
+// at a time.
+func (it *WAFPackageIterator) All(concurrency int) ([]WAFPackage, error) {
+	var packages []WAFPackage
+	if err := it.collect(concurrency, &packages); err != nil {
+		return nil, err
 	}
 	return packages, nil
 }
 
+// ListWAFPackagesIter returns an iterator over the WAF packages of a zone.
+func (api *API) ListWAFPackagesIter(ctx context.Context, zoneID string, pageOpts PaginationOptions) *WAFPackageIterator {
+	return &WAFPackageIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.listWAFPackages(ctx, zoneID, pageOpts)
+	})}
+}
+
 // WAFPackage returns a WAF package for the given zone.
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-packages-firewall-package-details
 func (api *API) WAFPackage(zoneID, packageID string) (WAFPackage, error) {
+	return api.WAFPackageContext(context.TODO(), zoneID, packageID)
+}
+
+// WAFPackageContext is like WAFPackage but takes a context.
+func (api *API) WAFPackageContext(ctx context.Context, zoneID, packageID string) (WAFPackage, error) {
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return WAFPackage{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -147,8 +189,13 @@ func (api *API) WAFPackage(zoneID, packageID string) (WAFPackage, error) {
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-packages-edit-firewall-package
 func (api *API) UpdateWAFPackage(zoneID, packageID string, opts WAFPackageOptions) (WAFPackage, error) {
+	return api.UpdateWAFPackageContext(context.TODO(), zoneID, packageID, opts)
+}
+
+// UpdateWAFPackageContext is like UpdateWAFPackage but takes a context.
+func (api *API) UpdateWAFPackageContext(ctx context.Context, zoneID, packageID string, opts WAFPackageOptions) (WAFPackage, error) {
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID
-	res, err := api.makeRequest("PATCH", uri, opts)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, opts)
 	if err != nil {
 		return WAFPackage{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -165,39 +212,78 @@ func (api *API) UpdateWAFPackage(zoneID, packageID string, opts WAFPackageOption
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-groups-list-rule-groups
 func (api *API) ListWAFGroups(zoneID, packageID string) ([]WAFGroup, error) {
-	var groups []WAFGroup
-	var res []byte
-	var err error
+	return api.ListWAFGroupsContext(context.TODO(), zoneID, packageID)
+}
 
-	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/groups"
-	res, err = api.makeRequest("GET", uri, nil)
+// ListWAFGroupsContext is like ListWAFGroups but takes a context.
+func (api *API) ListWAFGroupsContext(ctx context.Context, zoneID, packageID string) ([]WAFGroup, error) {
+	groups, err := api.ListWAFGroupsIter(ctx, zoneID, packageID, PaginationOptions{}).All(api.paginationConcurrency)
 	if err != nil {
-		return []WAFGroup{}, errors.Wrap(err, errMakeRequestError)
+		return []WAFGroup{}, err
+	}
+	return groups, nil
+}
+
+// listWAFGroups fetches a page of the WAF groups for the given WAF package.
+func (api *API) listWAFGroups(ctx context.Context, zoneID, packageID string, pageOpts PaginationOptions) ([]WAFGroup, ResultInfo, error) {
+	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/groups" + paginationQuery(pageOpts)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return []WAFGroup{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var r WAFGroupsResponse
 	err = json.Unmarshal(res, &r)
 	if err != nil {
-		return []WAFGroup{}, errors.Wrap(err, errUnmarshalError)
+		return []WAFGroup{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
 	if !r.Success {
 		// TODO: Provide an actual error message instead of always returning nil
-		return []WAFGroup{}, err
+		return []WAFGroup{}, ResultInfo{}, err
 	}
+	return r.Result, r.ResultInfo, nil
+}
 
-	for gi := range r.Result {
-		groups = append(groups, r.Result[gi])
+// WAFGroupIterator iterates over WAF groups. See Paginator.
+type WAFGroupIterator struct {
+	*Paginator
+}
+
+// Value returns the current WAF group.
+func (it *WAFGroupIterator) Value() WAFGroup {
+	v, _ := it.value().(WAFGroup)
+	return v
+}
+
+// All returns every remaining WAF group, fetching up to concurrency pages at
+// a time.
+func (it *WAFGroupIterator) All(concurrency int) ([]WAFGroup, error) {
+	var groups []WAFGroup
+	if err := it.collect(concurrency, &groups); err != nil {
+		return nil, err
 	}
 	return groups, nil
 }
 
+// ListWAFGroupsIter returns an iterator over the WAF groups of a WAF package.
+func (api *API) ListWAFGroupsIter(ctx context.Context, zoneID, packageID string, pageOpts PaginationOptions) *WAFGroupIterator {
+	return &WAFGroupIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.listWAFGroups(ctx, zoneID, packageID, pageOpts)
+	})}
+}
+
 // WAFGroup returns a WAF rule group from the given WAF package.
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-groups-rule-group-details
 func (api *API) WAFGroup(zoneID, packageID, groupID string) (WAFGroup, error) {
+	return api.WAFGroupContext(context.TODO(), zoneID, packageID, groupID)
+}
+
+// WAFGroupContext is like WAFGroup but takes a context.
+func (api *API) WAFGroupContext(ctx context.Context, zoneID, packageID, groupID string) (WAFGroup, error) {
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/groups/" + groupID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return WAFGroup{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -215,9 +301,14 @@ func (api *API) WAFGroup(zoneID, packageID, groupID string) (WAFGroup, error) {
 //
 // API Reference: https://api.cloudflare.com/#waf-rule-groups-edit-rule-group
 func (api *API) UpdateWAFGroup(zoneID, packageID, groupID, mode string) (WAFGroup, error) {
+	return api.UpdateWAFGroupContext(context.TODO(), zoneID, packageID, groupID, mode)
+}
+
+// UpdateWAFGroupContext is like UpdateWAFGroup but takes a context.
+func (api *API) UpdateWAFGroupContext(ctx context.Context, zoneID, packageID, groupID, mode string) (WAFGroup, error) {
 	opts := WAFRuleOptions{Mode: mode}
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/groups/" + groupID
-	res, err := api.makeRequest("PATCH", uri, opts)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, opts)
 	if err != nil {
 		return WAFGroup{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -234,39 +325,78 @@ func (api *API) UpdateWAFGroup(zoneID, packageID, groupID, mode string) (WAFGrou
 //
 // API Reference: https://api.cloudflare.com/#waf-rules-list-rules
 func (api *API) ListWAFRules(zoneID, packageID string) ([]WAFRule, error) {
-	var rules []WAFRule
-	var res []byte
-	var err error
+	return api.ListWAFRulesContext(context.TODO(), zoneID, packageID)
+}
+
+// ListWAFRulesContext is like ListWAFRules but takes a context.
+func (api *API) ListWAFRulesContext(ctx context.Context, zoneID, packageID string) ([]WAFRule, error) {
+	rules, err := api.ListWAFRulesIter(ctx, zoneID, packageID, PaginationOptions{}).All(api.paginationConcurrency)
+	if err != nil {
+		return []WAFRule{}, err
+	}
+	return rules, nil
+}
 
-	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/rules"
-	res, err = api.makeRequest("GET", uri, nil)
+// listWAFRules fetches a page of the WAF rules for the given WAF package.
+func (api *API) listWAFRules(ctx context.Context, zoneID, packageID string, pageOpts PaginationOptions) ([]WAFRule, ResultInfo, error) {
+	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/rules" + paginationQuery(pageOpts)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return []WAFRule{}, errors.Wrap(err, errMakeRequestError)
+		return []WAFRule{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var r WAFRulesResponse
 	err = json.Unmarshal(res, &r)
 	if err != nil {
-		return []WAFRule{}, errors.Wrap(err, errUnmarshalError)
+		return []WAFRule{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
 	if !r.Success {
 		// TODO: Provide an actual error message instead of always returning nil
-		return []WAFRule{}, err
+		return []WAFRule{}, ResultInfo{}, err
 	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// WAFRuleIterator iterates over WAF rules. See Paginator.
+type WAFRuleIterator struct {
+	*Paginator
+}
+
+// Value returns the current WAF rule.
+func (it *WAFRuleIterator) Value() WAFRule {
+	v, _ := it.value().(WAFRule)
+	return v
+}
 
-	for ri := range r.Result {
-		rules = append(rules, r.Result[ri])
+// All returns every remaining WAF rule, fetching up to concurrency pages at a
+// time.
+func (it *WAFRuleIterator) All(concurrency int) ([]WAFRule, error) {
+	var rules []WAFRule
+	if err := it.collect(concurrency, &rules); err != nil {
+		return nil, err
 	}
 	return rules, nil
 }
 
+// ListWAFRulesIter returns an iterator over the WAF rules of a WAF package.
+func (api *API) ListWAFRulesIter(ctx context.Context, zoneID, packageID string, pageOpts PaginationOptions) *WAFRuleIterator {
+	return &WAFRuleIterator{newPaginator(ctx, pageOpts, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		return api.listWAFRules(ctx, zoneID, packageID, pageOpts)
+	})}
+}
+
 // WAFRule returns a WAF rule from the given WAF package.
 //
 // API Reference: https://api.cloudflare.com/#waf-rules-rule-details
 func (api *API) WAFRule(zoneID, packageID, ruleID string) (WAFRule, error) {
+	return api.WAFRuleContext(context.TODO(), zoneID, packageID, ruleID)
+}
+
+// WAFRuleContext is like WAFRule but takes a context.
+func (api *API) WAFRuleContext(ctx context.Context, zoneID, packageID, ruleID string) (WAFRule, error) {
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/rules/" + ruleID
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return WAFRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -284,9 +414,14 @@ func (api *API) WAFRule(zoneID, packageID, ruleID string) (WAFRule, error) {
 //
 // API Reference: https://api.cloudflare.com/#waf-rules-edit-rule
 func (api *API) UpdateWAFRule(zoneID, packageID, ruleID, mode string) (WAFRule, error) {
+	return api.UpdateWAFRuleContext(context.TODO(), zoneID, packageID, ruleID, mode)
+}
+
+// UpdateWAFRuleContext is like UpdateWAFRule but takes a context.
+func (api *API) UpdateWAFRuleContext(ctx context.Context, zoneID, packageID, ruleID, mode string) (WAFRule, error) {
 	opts := WAFRuleOptions{Mode: mode}
 	uri := "/zones/" + zoneID + "/firewall/waf/packages/" + packageID + "/rules/" + ruleID
-	res, err := api.makeRequest("PATCH", uri, opts)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, opts)
 	if err != nil {
 		return WAFRule{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -38,7 +39,7 @@ func TestListWAFPackages(t *testing.T) {
 				"page": 1,
 				"per_page": 20,
 				"count": 1,
-				"total_count": 2000
+				"total_count": 1
 			}
 		}`)
 	}
@@ -67,6 +68,38 @@ func TestListWAFPackages(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestListWAFPackages_Pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/abcd123/firewall/waf/packages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		page := r.URL.Query().Get("page")
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "package-%s"}],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 3}
+		}`, page, page)
+	})
+
+	packages, err := client.ListWAFPackages("abcd123")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []WAFPackage{{ID: "package-1"}, {ID: "package-2"}, {ID: "package-3"}}, packages)
+	}
+
+	it := client.ListWAFPackagesIter(context.Background(), "abcd123", PaginationOptions{Page: 2, PerPage: 1})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"package-2", "package-3"}, ids)
+}
+
 func TestWAFPackage(t *testing.T) {
 	setup()
 	defer teardown()
@@ -205,7 +238,7 @@ func TestListWAFGroups(t *testing.T) {
 				"page": 1,
 				"per_page": 20,
 				"count": 1,
-				"total_count": 2000
+				"total_count": 1
 			}
 			}`)
 	}
@@ -383,7 +416,7 @@ func TestListWAFRules(t *testing.T) {
 				"page": 1,
 				"per_page": 20,
 				"count": 1,
-				"total_count": 2000
+				"total_count": 1
 			}
 		}`)
 	}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -226,12 +227,17 @@ func getRandomPartName() string {
 //
 // API reference: https://api.cloudflare.com/#worker-script-delete-worker
 func (api *API) DeleteWorker(requestParams *WorkerRequestParams) (WorkerScriptResponse, error) {
+	return api.DeleteWorkerContext(context.TODO(), requestParams)
+}
+
+// DeleteWorkerContext is like DeleteWorker but takes a context.
+func (api *API) DeleteWorkerContext(ctx context.Context, requestParams *WorkerRequestParams) (WorkerScriptResponse, error) {
 	// if ScriptName is provided we will treat as org request
 	if requestParams.ScriptName != "" {
-		return api.deleteWorkerWithName(requestParams.ScriptName)
+		return api.deleteWorkerWithName(ctx, requestParams.ScriptName)
 	}
 	uri := "/zones/" + requestParams.ZoneID + "/workers/script"
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -248,12 +254,12 @@ func (api *API) DeleteWorker(requestParams *WorkerRequestParams) (WorkerScriptRe
 // account must be specified as api option https://godoc.org/github.com/cloudflare/cloudflare-go#UsingAccount
 //
 // API reference: https://api.cloudflare.com/#worker-script-delete-worker
-func (api *API) deleteWorkerWithName(scriptName string) (WorkerScriptResponse, error) {
+func (api *API) deleteWorkerWithName(ctx context.Context, scriptName string) (WorkerScriptResponse, error) {
 	if api.AccountID == "" {
 		return WorkerScriptResponse{}, errors.New("account ID required for enterprise only request")
 	}
 	uri := "/accounts/" + api.AccountID + "/workers/scripts/" + scriptName
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -269,11 +275,16 @@ func (api *API) deleteWorkerWithName(scriptName string) (WorkerScriptResponse, e
 //
 // API reference: https://api.cloudflare.com/#worker-script-download-worker
 func (api *API) DownloadWorker(requestParams *WorkerRequestParams) (WorkerScriptResponse, error) {
+	return api.DownloadWorkerContext(context.TODO(), requestParams)
+}
+
+// DownloadWorkerContext is like DownloadWorker but takes a context.
+func (api *API) DownloadWorkerContext(ctx context.Context, requestParams *WorkerRequestParams) (WorkerScriptResponse, error) {
 	if requestParams.ScriptName != "" {
-		return api.downloadWorkerWithName(requestParams.ScriptName)
+		return api.downloadWorkerWithName(ctx, requestParams.ScriptName)
 	}
 	uri := "/zones/" + requestParams.ZoneID + "/workers/script"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -287,12 +298,12 @@ func (api *API) DownloadWorker(requestParams *WorkerRequestParams) (WorkerScript
 // This is an enterprise only feature https://developers.cloudflare.com/workers/api/config-api-for-enterprise/
 //
 // API reference: https://api.cloudflare.com/#worker-script-download-worker
-func (api *API) downloadWorkerWithName(scriptName string) (WorkerScriptResponse, error) {
+func (api *API) downloadWorkerWithName(ctx context.Context, scriptName string) (WorkerScriptResponse, error) {
 	if api.AccountID == "" {
 		return WorkerScriptResponse{}, errors.New("account ID required for enterprise only request")
 	}
 	uri := "/accounts/" + api.AccountID + "/workers/scripts/" + scriptName
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -304,6 +315,11 @@ func (api *API) downloadWorkerWithName(scriptName string) (WorkerScriptResponse,
 
 // ListWorkerBindings returns all the bindings for a particular worker
 func (api *API) ListWorkerBindings(requestParams *WorkerRequestParams) (WorkerBindingListResponse, error) {
+	return api.ListWorkerBindingsContext(context.TODO(), requestParams)
+}
+
+// ListWorkerBindingsContext is like ListWorkerBindings but takes a context.
+func (api *API) ListWorkerBindingsContext(ctx context.Context, requestParams *WorkerRequestParams) (WorkerBindingListResponse, error) {
 	if requestParams.ScriptName == "" {
 		return WorkerBindingListResponse{}, errors.New("ScriptName is required")
 	}
@@ -318,7 +334,7 @@ func (api *API) ListWorkerBindings(requestParams *WorkerRequestParams) (WorkerBi
 		Bindings []workerBindingMeta `json:"result"`
 	}
 	var r WorkerBindingListResponse
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
 	}
@@ -416,11 +432,16 @@ func (b *bindingContentReader) Read(p []byte) (n int, err error) {
 //
 // API reference: https://developers.cloudflare.com/workers/api/config-api-for-enterprise/
 func (api *API) ListWorkerScripts() (WorkerListResponse, error) {
+	return api.ListWorkerScriptsContext(context.TODO())
+}
+
+// ListWorkerScriptsContext is like ListWorkerScripts but takes a context.
+func (api *API) ListWorkerScriptsContext(ctx context.Context) (WorkerListResponse, error) {
 	if api.AccountID == "" {
 		return WorkerListResponse{}, errors.New("account ID required for enterprise only request")
 	}
 	uri := "/accounts/" + api.AccountID + "/workers/scripts"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return WorkerListResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -436,31 +457,41 @@ func (api *API) ListWorkerScripts() (WorkerListResponse, error) {
 //
 // API reference: https://api.cloudflare.com/#worker-script-upload-worker
 func (api *API) UploadWorker(requestParams *WorkerRequestParams, data string) (WorkerScriptResponse, error) {
+	return api.UploadWorkerContext(context.TODO(), requestParams, data)
+}
+
+// UploadWorkerContext is like UploadWorker but takes a context.
+func (api *API) UploadWorkerContext(ctx context.Context, requestParams *WorkerRequestParams, data string) (WorkerScriptResponse, error) {
 	if requestParams.ScriptName != "" {
-		return api.uploadWorkerWithName(requestParams.ScriptName, "application/javascript", []byte(data))
+		return api.uploadWorkerWithName(ctx, requestParams.ScriptName, "application/javascript", []byte(data))
 	}
-	return api.uploadWorkerForZone(requestParams.ZoneID, "application/javascript", []byte(data))
+	return api.uploadWorkerForZone(ctx, requestParams.ZoneID, "application/javascript", []byte(data))
 }
 
 // UploadWorkerWithBindings push raw script content and bindings for your worker
 //
 // API reference: https://api.cloudflare.com/#worker-script-upload-worker
 func (api *API) UploadWorkerWithBindings(requestParams *WorkerRequestParams, data *WorkerScriptParams) (WorkerScriptResponse, error) {
+	return api.UploadWorkerWithBindingsContext(context.TODO(), requestParams, data)
+}
+
+// UploadWorkerWithBindingsContext is like UploadWorkerWithBindings but takes a context.
+func (api *API) UploadWorkerWithBindingsContext(ctx context.Context, requestParams *WorkerRequestParams, data *WorkerScriptParams) (WorkerScriptResponse, error) {
 	contentType, body, err := formatMultipartBody(data)
 	if err != nil {
 		return WorkerScriptResponse{}, err
 	}
 	if requestParams.ScriptName != "" {
-		return api.uploadWorkerWithName(requestParams.ScriptName, contentType, body)
+		return api.uploadWorkerWithName(ctx, requestParams.ScriptName, contentType, body)
 	}
-	return api.uploadWorkerForZone(requestParams.ZoneID, contentType, body)
+	return api.uploadWorkerForZone(ctx, requestParams.ZoneID, contentType, body)
 }
 
-func (api *API) uploadWorkerForZone(zoneID, contentType string, body []byte) (WorkerScriptResponse, error) {
+func (api *API) uploadWorkerForZone(ctx context.Context, zoneID, contentType string, body []byte) (WorkerScriptResponse, error) {
 	uri := "/zones/" + zoneID + "/workers/script"
 	headers := make(http.Header)
 	headers.Set("Content-Type", contentType)
-	res, err := api.makeRequestWithHeaders("PUT", uri, body, headers)
+	res, err := api.makeRequestContextWithHeaders(ctx, "PUT", uri, body, headers)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -472,14 +503,14 @@ func (api *API) uploadWorkerForZone(zoneID, contentType string, body []byte) (Wo
 	return r, nil
 }
 
-func (api *API) uploadWorkerWithName(scriptName, contentType string, body []byte) (WorkerScriptResponse, error) {
+func (api *API) uploadWorkerWithName(ctx context.Context, scriptName, contentType string, body []byte) (WorkerScriptResponse, error) {
 	if api.AccountID == "" {
 		return WorkerScriptResponse{}, errors.New("account ID required for enterprise only request")
 	}
 	uri := "/accounts/" + api.AccountID + "/workers/scripts/" + scriptName
 	headers := make(http.Header)
 	headers.Set("Content-Type", contentType)
-	res, err := api.makeRequestWithHeaders("PUT", uri, body, headers)
+	res, err := api.makeRequestContextWithHeaders(ctx, "PUT", uri, body, headers)
 	var r WorkerScriptResponse
 	if err != nil {
 		return r, errors.Wrap(err, errMakeRequestError)
@@ -566,6 +597,11 @@ func formatMultipartBody(params *WorkerScriptParams) (string, []byte, error) {
 //
 // API reference: https://api.cloudflare.com/#worker-filters-create-filter, https://api.cloudflare.com/#worker-routes-create-route
 func (api *API) CreateWorkerRoute(zoneID string, route WorkerRoute) (WorkerRouteResponse, error) {
+	return api.CreateWorkerRouteContext(context.TODO(), zoneID, route)
+}
+
+// CreateWorkerRouteContext is like CreateWorkerRoute but takes a context.
+func (api *API) CreateWorkerRouteContext(ctx context.Context, zoneID string, route WorkerRoute) (WorkerRouteResponse, error) {
 	// Check whether a script name is defined in order to determine whether
 	// to use the single-script or multi-script endpoint.
 	pathComponent := "filters"
@@ -577,7 +613,7 @@ func (api *API) CreateWorkerRoute(zoneID string, route WorkerRoute) (WorkerRoute
 	}
 
 	uri := "/zones/" + zoneID + "/workers/" + pathComponent
-	res, err := api.makeRequest("POST", uri, route)
+	res, err := api.makeRequestContext(ctx, "POST", uri, route)
 	if err != nil {
 		return WorkerRouteResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -593,8 +629,13 @@ func (api *API) CreateWorkerRoute(zoneID string, route WorkerRoute) (WorkerRoute
 //
 // API reference: https://api.cloudflare.com/#worker-routes-delete-route
 func (api *API) DeleteWorkerRoute(zoneID string, routeID string) (WorkerRouteResponse, error) {
+	return api.DeleteWorkerRouteContext(context.TODO(), zoneID, routeID)
+}
+
+// DeleteWorkerRouteContext is like DeleteWorkerRoute but takes a context.
+func (api *API) DeleteWorkerRouteContext(ctx context.Context, zoneID string, routeID string) (WorkerRouteResponse, error) {
 	uri := "/zones/" + zoneID + "/workers/routes/" + routeID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return WorkerRouteResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -610,12 +651,17 @@ func (api *API) DeleteWorkerRoute(zoneID string, routeID string) (WorkerRouteRes
 //
 // API reference: https://api.cloudflare.com/#worker-filters-list-filters, https://api.cloudflare.com/#worker-routes-list-routes
 func (api *API) ListWorkerRoutes(zoneID string) (WorkerRoutesResponse, error) {
+	return api.ListWorkerRoutesContext(context.TODO(), zoneID)
+}
+
+// ListWorkerRoutesContext is like ListWorkerRoutes but takes a context.
+func (api *API) ListWorkerRoutesContext(ctx context.Context, zoneID string) (WorkerRoutesResponse, error) {
 	pathComponent := "filters"
 	if api.AccountID != "" {
 		pathComponent = "routes"
 	}
 	uri := "/zones/" + zoneID + "/workers/" + pathComponent
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return WorkerRoutesResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -640,6 +686,11 @@ func (api *API) ListWorkerRoutes(zoneID string) (WorkerRoutesResponse, error) {
 //
 // API reference: https://api.cloudflare.com/#worker-filters-update-filter, https://api.cloudflare.com/#worker-routes-update-route
 func (api *API) UpdateWorkerRoute(zoneID string, routeID string, route WorkerRoute) (WorkerRouteResponse, error) {
+	return api.UpdateWorkerRouteContext(context.TODO(), zoneID, routeID, route)
+}
+
+// UpdateWorkerRouteContext is like UpdateWorkerRoute but takes a context.
+func (api *API) UpdateWorkerRouteContext(ctx context.Context, zoneID string, routeID string, route WorkerRoute) (WorkerRouteResponse, error) {
 	// Check whether a script name is defined in order to determine whether
 	// to use the single-script or multi-script endpoint.
 	pathComponent := "filters"
@@ -650,7 +701,7 @@ func (api *API) UpdateWorkerRoute(zoneID string, routeID string, route WorkerRou
 		pathComponent = "routes"
 	}
 	uri := "/zones/" + zoneID + "/workers/" + pathComponent + "/" + routeID
-	res, err := api.makeRequest("PUT", uri, route)
+	res, err := api.makeRequestContext(ctx, "PUT", uri, route)
 	if err != nil {
 		return WorkerRouteResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
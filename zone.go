@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -247,15 +246,54 @@ type ZoneAnalyticsOptions struct {
 }
 
 // PurgeCacheRequest represents the request format made to the purge endpoint.
+//
+// Each list is limited to 30 entries per request; BatchPurgeCache splits
+// longer lists into several requests.
 type PurgeCacheRequest struct {
 	Everything bool `json:"purge_everything,omitempty"`
 	// Purge by filepath (exact match). Limit of 30
 	Files []string `json:"files,omitempty"`
+	// Purge by filepath with the request headers that make up the cache key.
+	// These are sent as part of files, alongside Files.
+	FilesWithHeaders []PurgeCacheFile `json:"-"`
 	// Purge by Tag (Enterprise only):
 	// https://support.cloudflare.com/hc/en-us/articles/206596608-How-to-Purge-Cache-Using-Cache-Tags-Enterprise-only-
 	Tags []string `json:"tags,omitempty"`
 	// Purge by hostname - e.g. "assets.example.com"
 	Hosts []string `json:"hosts,omitempty"`
+	// Purge by URL prefix - e.g. "www.example.com/images" (Enterprise only)
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// MarshalJSON sends FilesWithHeaders as part of files.
+func (pcr PurgeCacheRequest) MarshalJSON() ([]byte, error) {
+	type request PurgeCacheRequest
+	var files []PurgeCacheFile
+	for _, file := range pcr.Files {
+		files = append(files, PurgeCacheFile{URL: file})
+	}
+	files = append(files, pcr.FilesWithHeaders...)
+	return json.Marshal(struct {
+		request
+		Files []PurgeCacheFile `json:"files,omitempty"`
+	}{request(pcr), files})
+}
+
+// PurgeCacheFile is a file to purge from the cache, identified by its URL
+// and the request headers that make up its cache key, such as Origin or
+// CF-Device-Type.
+type PurgeCacheFile struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// MarshalJSON sends a file without headers as just its URL.
+func (f PurgeCacheFile) MarshalJSON() ([]byte, error) {
+	if len(f.Headers) == 0 {
+		return json.Marshal(f.URL)
+	}
+	type file PurgeCacheFile
+	return json.Marshal(file(f))
 }
 
 // PurgeCacheResponse represents the response from the purge endpoint.
@@ -304,8 +342,17 @@ type zoneSubscriptionRatePlanPayload struct {
 // If account is non-empty, it must have at least the ID field populated.
 // This will add the new zone to the specified multi-user account.
 //
+// zoneType is "full", "partial" (CNAME setup) or "secondary", for a zone
+// transferred from a primary nameserver; see CreateSecondaryDNSZoneConfig.
+// Any other value creates a full zone.
+//
 // API reference: https://api.cloudflare.com/#zone-create-a-zone
 func (api *API) CreateZone(name string, jumpstart bool, account Account, zoneType string) (Zone, error) {
+	return api.CreateZoneContext(context.TODO(), name, jumpstart, account, zoneType)
+}
+
+// CreateZoneContext is like CreateZone but takes a context.
+func (api *API) CreateZoneContext(ctx context.Context, name string, jumpstart bool, account Account, zoneType string) (Zone, error) {
 	var newzone newZone
 	newzone.Name = name
 	newzone.JumpStart = jumpstart
@@ -313,13 +360,14 @@ func (api *API) CreateZone(name string, jumpstart bool, account Account, zoneTyp
 		newzone.Account = &account
 	}
 
-	if zoneType == "partial" {
-		newzone.Type = "partial"
-	} else {
+	switch zoneType {
+	case "partial", "secondary":
+		newzone.Type = zoneType
+	default:
 		newzone.Type = "full"
 	}
 
-	res, err := api.makeRequest("POST", "/zones", newzone)
+	res, err := api.makeRequestContext(ctx, "POST", "/zones", newzone)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -336,7 +384,12 @@ func (api *API) CreateZone(name string, jumpstart bool, account Account, zoneTyp
 //
 // API reference: https://api.cloudflare.com/#zone-initiate-another-zone-activation-check
 func (api *API) ZoneActivationCheck(zoneID string) (Response, error) {
-	res, err := api.makeRequest("PUT", "/zones/"+zoneID+"/activation_check", nil)
+	return api.ZoneActivationCheckContext(context.TODO(), zoneID)
+}
+
+// ZoneActivationCheckContext is like ZoneActivationCheck but takes a context.
+func (api *API) ZoneActivationCheckContext(ctx context.Context, zoneID string) (Response, error) {
+	res, err := api.makeRequestContext(ctx, "PUT", "/zones/"+zoneID+"/activation_check", nil)
 	if err != nil {
 		return Response{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -353,75 +406,33 @@ func (api *API) ZoneActivationCheck(zoneID string) (Response, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-list-zones
 func (api *API) ListZones(z ...string) ([]Zone, error) {
-	v := url.Values{}
-	var res []byte
-	var r ZonesResponse
-	var zones []Zone
-	var err error
-	if len(z) > 0 {
-		for _, zone := range z {
-			v.Set("name", normalizeZoneName(zone))
-			res, err = api.makeRequest("GET", "/zones?"+v.Encode(), nil)
-			if err != nil {
-				return []Zone{}, errors.Wrap(err, errMakeRequestError)
-			}
-			err = json.Unmarshal(res, &r)
-			if err != nil {
-				return []Zone{}, errors.Wrap(err, errUnmarshalError)
-			}
-			if !r.Success {
-				// TODO: Provide an actual error message instead of always returning nil
-				return []Zone{}, err
-			}
-			for zi := range r.Result {
-				zones = append(zones, r.Result[zi])
-			}
-		}
-	} else {
-		res, err = api.makeRequest("GET", "/zones?per_page=50", nil)
-		if err != nil {
-			return []Zone{}, errors.Wrap(err, errMakeRequestError)
-		}
-		err = json.Unmarshal(res, &r)
+	return api.ListZonesByNameContext(context.TODO(), z...)
+}
+
+// ListZonesByNameContext is like ListZones but takes a context. It fetches
+// every zone, or every zone matching one of names, using up to
+// api.paginationConcurrency concurrent requests per listing. Zones are
+// returned in the order the API lists them.
+func (api *API) ListZonesByNameContext(ctx context.Context, names ...string) ([]Zone, error) {
+	// Request as many zones as possible per page - API max is 50
+	opts := []ReqOption{WithPagination(PaginationOptions{PerPage: 50})}
+
+	if len(names) == 0 {
+		zones, err := api.ListZonesIter(ctx, opts...).All(api.paginationConcurrency)
 		if err != nil {
-			return []Zone{}, errors.Wrap(err, errUnmarshalError)
+			return []Zone{}, err
 		}
+		return zones, nil
+	}
 
-		totalPageCount := r.TotalPages
-		var wg sync.WaitGroup
-		wg.Add(totalPageCount)
-		errc := make(chan error)
-
-		for i := 1; i <= totalPageCount; i++ {
-			go func(pageNumber int) error {
-				res, err = api.makeRequest("GET", fmt.Sprintf("/zones?per_page=50&page=%d", pageNumber), nil)
-				if err != nil {
-					errc <- err
-				}
-
-				err = json.Unmarshal(res, &r)
-				if err != nil {
-					errc <- err
-				}
-
-				for _, zone := range r.Result {
-					zones = append(zones, zone)
-				}
-
-				select {
-				case err := <-errc:
-					return err
-				default:
-					wg.Done()
-				}
-
-				return nil
-			}(i)
+	var zones []Zone
+	for _, name := range names {
+		matched, err := api.ListZonesIter(ctx, append(opts, WithZoneFilter(name))...).All(api.paginationConcurrency)
+		if err != nil {
+			return []Zone{}, err
 		}
-
-		wg.Wait()
+		zones = append(zones, matched...)
 	}
-
 	return zones, nil
 }
 
@@ -447,11 +458,50 @@ func (api *API) ListZonesContext(ctx context.Context, opts ...ReqOption) (r Zone
 	return r, nil
 }
 
+// ZoneIterator iterates over zones. See Paginator.
+type ZoneIterator struct {
+	*Paginator
+}
+
+// Value returns the current zone.
+func (it *ZoneIterator) Value() Zone {
+	v, _ := it.value().(Zone)
+	return v
+}
+
+// All returns every remaining zone, fetching up to concurrency pages at a time.
+func (it *ZoneIterator) All(concurrency int) ([]Zone, error) {
+	var zones []Zone
+	if err := it.collect(concurrency, &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// ListZonesIter returns an iterator over the zones on an account. It takes
+// the same ReqOptions as ListZonesContext; the page is set by the iterator.
+func (api *API) ListZonesIter(ctx context.Context, opts ...ReqOption) *ZoneIterator {
+	return &ZoneIterator{newPaginator(ctx, PaginationOptions{}, func(ctx context.Context, pageOpts PaginationOptions) (interface{}, ResultInfo, error) {
+		// copy opts, as pages may be fetched concurrently
+		pageReqOpts := append(append([]ReqOption{}, opts...), withPage(pageOpts))
+		r, err := api.ListZonesContext(ctx, pageReqOpts...)
+		if err != nil {
+			return nil, ResultInfo{}, err
+		}
+		return r.Result, r.ResultInfo, nil
+	})}
+}
+
 // ZoneDetails fetches information about a zone.
 //
 // API reference: https://api.cloudflare.com/#zone-zone-details
 func (api *API) ZoneDetails(zoneID string) (Zone, error) {
-	res, err := api.makeRequest("GET", "/zones/"+zoneID, nil)
+	return api.ZoneDetailsContext(context.TODO(), zoneID)
+}
+
+// ZoneDetailsContext is like ZoneDetails but takes a context.
+func (api *API) ZoneDetailsContext(ctx context.Context, zoneID string) (Zone, error) {
+	res, err := api.makeRequestContext(ctx, "GET", "/zones/"+zoneID, nil)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -473,8 +523,13 @@ type ZoneOptions struct {
 // ZoneSetPaused pauses Cloudflare service for the entire zone, sending all
 // traffic direct to the origin.
 func (api *API) ZoneSetPaused(zoneID string, paused bool) (Zone, error) {
+	return api.ZoneSetPausedContext(context.TODO(), zoneID, paused)
+}
+
+// ZoneSetPausedContext is like ZoneSetPaused but takes a context.
+func (api *API) ZoneSetPausedContext(ctx context.Context, zoneID string, paused bool) (Zone, error) {
 	zoneopts := ZoneOptions{Paused: &paused}
-	zone, err := api.EditZone(zoneID, zoneopts)
+	zone, err := api.EditZoneContext(ctx, zoneID, zoneopts)
 	if err != nil {
 		return Zone{}, err
 	}
@@ -485,8 +540,13 @@ func (api *API) ZoneSetPaused(zoneID string, paused bool) (Zone, error) {
 // ZoneSetVanityNS sets custom nameservers for the zone.
 // These names must be within the same zone.
 func (api *API) ZoneSetVanityNS(zoneID string, ns []string) (Zone, error) {
+	return api.ZoneSetVanityNSContext(context.TODO(), zoneID, ns)
+}
+
+// ZoneSetVanityNSContext is like ZoneSetVanityNS but takes a context.
+func (api *API) ZoneSetVanityNSContext(ctx context.Context, zoneID string, ns []string) (Zone, error) {
 	zoneopts := ZoneOptions{VanityNS: ns}
-	zone, err := api.EditZone(zoneID, zoneopts)
+	zone, err := api.EditZoneContext(ctx, zoneID, zoneopts)
 	if err != nil {
 		return Zone{}, err
 	}
@@ -501,12 +561,17 @@ func (api *API) ZoneSetVanityNS(zoneID string, ns []string) (Zone, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-subscription-create-zone-subscription
 func (api *API) ZoneSetPlan(zoneID string, planType string) error {
+	return api.ZoneSetPlanContext(context.TODO(), zoneID, planType)
+}
+
+// ZoneSetPlanContext is like ZoneSetPlan but takes a context.
+func (api *API) ZoneSetPlanContext(ctx context.Context, zoneID string, planType string) error {
 	zonePayload := zoneSubscriptionRatePlanPayload{}
 	zonePayload.RatePlan.ID = planType
 
 	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
 
-	_, err := api.makeRequest("POST", uri, zonePayload)
+	_, err := api.makeRequestContext(ctx, "POST", uri, zonePayload)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -521,12 +586,17 @@ func (api *API) ZoneSetPlan(zoneID string, planType string) error {
 //
 // API reference: https://api.cloudflare.com/#zone-subscription-update-zone-subscription
 func (api *API) ZoneUpdatePlan(zoneID string, planType string) error {
+	return api.ZoneUpdatePlanContext(context.TODO(), zoneID, planType)
+}
+
+// ZoneUpdatePlanContext is like ZoneUpdatePlan but takes a context.
+func (api *API) ZoneUpdatePlanContext(ctx context.Context, zoneID string, planType string) error {
 	zonePayload := zoneSubscriptionRatePlanPayload{}
 	zonePayload.RatePlan.ID = planType
 
 	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
 
-	_, err := api.makeRequest("PUT", uri, zonePayload)
+	_, err := api.makeRequestContext(ctx, "PUT", uri, zonePayload)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
@@ -540,7 +610,12 @@ func (api *API) ZoneUpdatePlan(zoneID string, planType string) error {
 //
 // API reference: https://api.cloudflare.com/#zone-edit-zone-properties
 func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
-	res, err := api.makeRequest("PATCH", "/zones/"+zoneID, zoneOpts)
+	return api.EditZoneContext(context.TODO(), zoneID, zoneOpts)
+}
+
+// EditZoneContext is like EditZone but takes a context.
+func (api *API) EditZoneContext(ctx context.Context, zoneID string, zoneOpts ZoneOptions) (Zone, error) {
+	res, err := api.makeRequestContext(ctx, "PATCH", "/zones/"+zoneID, zoneOpts)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -560,8 +635,13 @@ func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-purge-all-files
 func (api *API) PurgeEverything(zoneID string) (PurgeCacheResponse, error) {
+	return api.PurgeEverythingContext(context.TODO(), zoneID)
+}
+
+// PurgeEverythingContext is like PurgeEverything but takes a context.
+func (api *API) PurgeEverythingContext(ctx context.Context, zoneID string) (PurgeCacheResponse, error) {
 	uri := "/zones/" + zoneID + "/purge_cache"
-	res, err := api.makeRequest("POST", uri, PurgeCacheRequest{true, nil, nil, nil})
+	res, err := api.makeRequestContext(ctx, "POST", uri, PurgeCacheRequest{Everything: true})
 	if err != nil {
 		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -577,8 +657,13 @@ func (api *API) PurgeEverything(zoneID string) (PurgeCacheResponse, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-purge-individual-files-by-url-and-cache-tags
 func (api *API) PurgeCache(zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
+	return api.PurgeCacheContext(context.TODO(), zoneID, pcr)
+}
+
+// PurgeCacheContext is like PurgeCache but takes a context.
+func (api *API) PurgeCacheContext(ctx context.Context, zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
 	uri := "/zones/" + zoneID + "/purge_cache"
-	res, err := api.makeRequest("POST", uri, pcr)
+	res, err := api.makeRequestContext(ctx, "POST", uri, pcr)
 	if err != nil {
 		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -594,7 +679,12 @@ func (api *API) PurgeCache(zoneID string, pcr PurgeCacheRequest) (PurgeCacheResp
 //
 // API reference: https://api.cloudflare.com/#zone-delete-a-zone
 func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
-	res, err := api.makeRequest("DELETE", "/zones/"+zoneID, nil)
+	return api.DeleteZoneContext(context.TODO(), zoneID)
+}
+
+// DeleteZoneContext is like DeleteZone but takes a context.
+func (api *API) DeleteZoneContext(ctx context.Context, zoneID string) (ZoneID, error) {
+	res, err := api.makeRequestContext(ctx, "DELETE", "/zones/"+zoneID, nil)
 	if err != nil {
 		return ZoneID{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -610,8 +700,13 @@ func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-plan-available-plans
 func (api *API) AvailableZoneRatePlans(zoneID string) ([]ZoneRatePlan, error) {
+	return api.AvailableZoneRatePlansContext(context.TODO(), zoneID)
+}
+
+// AvailableZoneRatePlansContext is like AvailableZoneRatePlans but takes a context.
+func (api *API) AvailableZoneRatePlansContext(ctx context.Context, zoneID string) ([]ZoneRatePlan, error) {
 	uri := "/zones/" + zoneID + "/available_rate_plans"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []ZoneRatePlan{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -627,8 +722,13 @@ func (api *API) AvailableZoneRatePlans(zoneID string) ([]ZoneRatePlan, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-rate-plan-list-available-plans
 func (api *API) AvailableZonePlans(zoneID string) ([]ZonePlan, error) {
+	return api.AvailableZonePlansContext(context.TODO(), zoneID)
+}
+
+// AvailableZonePlansContext is like AvailableZonePlans but takes a context.
+func (api *API) AvailableZonePlansContext(ctx context.Context, zoneID string) ([]ZonePlan, error) {
 	uri := "/zones/" + zoneID + "/available_plans"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return []ZonePlan{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -659,8 +759,13 @@ func (o ZoneAnalyticsOptions) encode() string {
 //
 // API reference: https://api.cloudflare.com/#zone-analytics-dashboard
 func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptions) (ZoneAnalyticsData, error) {
+	return api.ZoneAnalyticsDashboardContext(context.TODO(), zoneID, options)
+}
+
+// ZoneAnalyticsDashboardContext is like ZoneAnalyticsDashboard but takes a context.
+func (api *API) ZoneAnalyticsDashboardContext(ctx context.Context, zoneID string, options ZoneAnalyticsOptions) (ZoneAnalyticsData, error) {
 	uri := "/zones/" + zoneID + "/analytics/dashboard" + "?" + options.encode()
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ZoneAnalyticsData{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -676,8 +781,13 @@ func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptio
 //
 // API reference: https://api.cloudflare.com/#zone-analytics-analytics-by-co-locations
 func (api *API) ZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions) ([]ZoneAnalyticsColocation, error) {
+	return api.ZoneAnalyticsByColocationContext(context.TODO(), zoneID, options)
+}
+
+// ZoneAnalyticsByColocationContext is like ZoneAnalyticsByColocation but takes a context.
+func (api *API) ZoneAnalyticsByColocationContext(ctx context.Context, zoneID string, options ZoneAnalyticsOptions) ([]ZoneAnalyticsColocation, error) {
 	uri := "/zones/" + zoneID + "/analytics/colos" + "?" + options.encode()
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -693,8 +803,13 @@ func (api *API) ZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOp
 //
 // API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
 func (api *API) ZoneSettings(zoneID string) (*ZoneSettingResponse, error) {
+	return api.ZoneSettingsContext(context.TODO(), zoneID)
+}
+
+// ZoneSettingsContext is like ZoneSettings but takes a context.
+func (api *API) ZoneSettingsContext(ctx context.Context, zoneID string) (*ZoneSettingResponse, error) {
 	uri := "/zones/" + zoneID + "/settings"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -712,8 +827,13 @@ func (api *API) ZoneSettings(zoneID string) (*ZoneSettingResponse, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
 func (api *API) UpdateZoneSettings(zoneID string, settings []ZoneSetting) (*ZoneSettingResponse, error) {
+	return api.UpdateZoneSettingsContext(context.TODO(), zoneID, settings)
+}
+
+// UpdateZoneSettingsContext is like UpdateZoneSettings but takes a context.
+func (api *API) UpdateZoneSettingsContext(ctx context.Context, zoneID string, settings []ZoneSetting) (*ZoneSettingResponse, error) {
 	uri := "/zones/" + zoneID + "/settings"
-	res, err := api.makeRequest("PATCH", uri, struct {
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, struct {
 		Items []ZoneSetting `json:"items"`
 	}{settings})
 	if err != nil {
@@ -733,8 +853,13 @@ func (api *API) UpdateZoneSettings(zoneID string, settings []ZoneSetting) (*Zone
 //
 // API reference: https://api.cloudflare.com/#zone-settings-get-ssl-setting
 func (api *API) ZoneSSLSettings(zoneID string) (ZoneSSLSetting, error) {
+	return api.ZoneSSLSettingsContext(context.TODO(), zoneID)
+}
+
+// ZoneSSLSettingsContext is like ZoneSSLSettings but takes a context.
+func (api *API) ZoneSSLSettingsContext(ctx context.Context, zoneID string) (ZoneSSLSetting, error) {
 	uri := "/zones/" + zoneID + "/settings/ssl"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ZoneSSLSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -750,8 +875,13 @@ func (api *API) ZoneSSLSettings(zoneID string) (ZoneSSLSetting, error) {
 //
 // API reference: https://developers.cloudflare.com/ssl/ssl-for-saas/api-calls/#fallback-origin-configuration
 func (api *API) FallbackOrigin(zoneID string) (FallbackOrigin, error) {
+	return api.FallbackOriginContext(context.TODO(), zoneID)
+}
+
+// FallbackOriginContext is like FallbackOrigin but takes a context.
+func (api *API) FallbackOriginContext(ctx context.Context, zoneID string) (FallbackOrigin, error) {
 	uri := "/zones/" + zoneID + "/fallback_origin"
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return FallbackOrigin{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -769,8 +899,13 @@ func (api *API) FallbackOrigin(zoneID string) (FallbackOrigin, error) {
 //
 // API reference: https://developers.cloudflare.com/ssl/ssl-for-saas/api-calls/#4-example-patch-to-change-fallback-origin
 func (api *API) UpdateFallbackOrigin(zoneID string, fbo FallbackOrigin) (*FallbackOriginResponse, error) {
+	return api.UpdateFallbackOriginContext(context.TODO(), zoneID, fbo)
+}
+
+// UpdateFallbackOriginContext is like UpdateFallbackOrigin but takes a context.
+func (api *API) UpdateFallbackOriginContext(ctx context.Context, zoneID string, fbo FallbackOrigin) (*FallbackOriginResponse, error) {
 	uri := "/zones/" + zoneID + "/fallback_origin"
-	res, err := api.makeRequest("PATCH", uri, fbo)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, fbo)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -801,8 +936,13 @@ func normalizeZoneName(name string) string {
 //
 // API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
 func (api *API) ZoneSingleSetting(zoneID, settingName string) (ZoneSetting, error) {
+	return api.ZoneSingleSettingContext(context.TODO(), zoneID, settingName)
+}
+
+// ZoneSingleSettingContext is like ZoneSingleSetting but takes a context.
+func (api *API) ZoneSingleSettingContext(ctx context.Context, zoneID, settingName string) (ZoneSetting, error) {
 	uri := "/zones/" + zoneID + "/settings/" + settingName
-	res, err := api.makeRequest("GET", uri, nil)
+	res, err := api.makeRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return ZoneSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
@@ -818,8 +958,13 @@ func (api *API) ZoneSingleSetting(zoneID, settingName string) (ZoneSetting, erro
 //
 // API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
 func (api *API) UpdateZoneSingleSetting(zoneID, settingName string, setting ZoneSetting) (*ZoneSettingSingleResponse, error) {
+	return api.UpdateZoneSingleSettingContext(context.TODO(), zoneID, settingName, setting)
+}
+
+// UpdateZoneSingleSettingContext is like UpdateZoneSingleSetting but takes a context.
+func (api *API) UpdateZoneSingleSettingContext(ctx context.Context, zoneID, settingName string, setting ZoneSetting) (*ZoneSettingSingleResponse, error) {
 	uri := "/zones/" + zoneID + "/settings/" + settingName
-	res, err := api.makeRequest("PATCH", uri, setting)
+	res, err := api.makeRequestContext(ctx, "PATCH", uri, setting)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
@@ -0,0 +1,271 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneAnalyticsRangeOptions configures ZoneAnalyticsRange.
+type ZoneAnalyticsRangeOptions struct {
+	// Since is required.
+	Since time.Time
+	// Until defaults to now.
+	Until time.Time
+	// Window is the longest time range to request at once; the default is
+	// 7 days. The API chooses the granularity of the time series by the
+	// length of the range requested, so windows of the same length get the
+	// same granularity; the last window is usually shorter and so may come
+	// back at a finer granularity than the rest.
+	Window time.Duration
+	// Continuous is passed on to ZoneAnalyticsDashboard.
+	Continuous *bool
+	// Concurrency is the most requests to have in flight at once, or
+	// DefaultBatchConcurrency if zero.
+	Concurrency int
+}
+
+// ZoneAnalyticsReport holds the analytics of several zones over a time
+// range.
+type ZoneAnalyticsReport struct {
+	// Zones holds the analytics of each zone by ID.
+	Zones map[string]ZoneAnalyticsData
+	// Totals holds the analytics of all of the zones added together.
+	Totals ZoneAnalyticsData
+}
+
+// ZoneAnalyticsRange fetches the dashboard analytics of zones over a time
+// range of any length, splitting it into windows the API accepts and
+// fetching them several at a time. The time series of each zone's windows
+// are joined together, and the report's totals add up all of the zones.
+//
+// API reference: https://api.cloudflare.com/#zone-analytics-dashboard
+func (api *API) ZoneAnalyticsRange(zoneIDs []string, opts ZoneAnalyticsRangeOptions) (ZoneAnalyticsReport, error) {
+	return api.ZoneAnalyticsRangeContext(context.TODO(), zoneIDs, opts)
+}
+
+// ZoneAnalyticsRangeContext is like ZoneAnalyticsRange but takes a context.
+func (api *API) ZoneAnalyticsRangeContext(ctx context.Context, zoneIDs []string, opts ZoneAnalyticsRangeOptions) (ZoneAnalyticsReport, error) {
+	if opts.Since.IsZero() {
+		return ZoneAnalyticsReport{}, errors.New("since is required")
+	}
+	until := opts.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if !until.After(opts.Since) {
+		return ZoneAnalyticsReport{}, errors.New("until must be after since")
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = 7 * 24 * time.Hour
+	}
+
+	type fetch struct {
+		zoneID       string
+		since, until time.Time
+		data         ZoneAnalyticsData
+	}
+	var fetches []fetch
+	for _, zoneID := range zoneIDs {
+		for since := opts.Since; since.Before(until); since = since.Add(window) {
+			end := since.Add(window)
+			if end.After(until) {
+				end = until
+			}
+			fetches = append(fetches, fetch{zoneID: zoneID, since: since, until: end})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+	forEachConcurrently(opts.Concurrency, len(fetches), func(i int) {
+		f := &fetches[i]
+		data, err := api.ZoneAnalyticsDashboardContext(ctx, f.zoneID, ZoneAnalyticsOptions{
+			Since:      &f.since,
+			Until:      &f.until,
+			Continuous: opts.Continuous,
+		})
+		if err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "could not fetch analytics of zone %s from %s to %s",
+					f.zoneID, f.since.Format(time.RFC3339), f.until.Format(time.RFC3339))
+				cancel()
+			}
+			mu.Unlock()
+			return
+		}
+		f.data = data
+	})
+	if firstErr != nil {
+		return ZoneAnalyticsReport{}, firstErr
+	}
+
+	windows := make(map[string][]ZoneAnalyticsData, len(zoneIDs))
+	for _, f := range fetches {
+		windows[f.zoneID] = append(windows[f.zoneID], f.data)
+	}
+	report := ZoneAnalyticsReport{Zones: make(map[string]ZoneAnalyticsData, len(windows))}
+	all := make([]ZoneAnalyticsData, 0, len(windows))
+	for zoneID, data := range windows {
+		merged := MergeZoneAnalytics(data...)
+		report.Zones[zoneID] = merged
+		all = append(all, merged)
+	}
+	report.Totals = MergeZoneAnalytics(all...)
+	return report, nil
+}
+
+// MergeZoneAnalytics adds together the analytics of several zones or
+// windows of time. The entries of the time series that cover the same time
+// are added together, and the time series is sorted by time.
+func MergeZoneAnalytics(data ...ZoneAnalyticsData) ZoneAnalyticsData {
+	var merged ZoneAnalyticsData
+	type span struct{ since, until time.Time }
+	entries := make(map[span]int)
+	for _, d := range data {
+		merged.Totals.add(d.Totals)
+		for _, entry := range d.Timeseries {
+			key := span{entry.Since, entry.Until}
+			i, ok := entries[key]
+			if !ok {
+				i = len(merged.Timeseries)
+				entries[key] = i
+				merged.Timeseries = append(merged.Timeseries, ZoneAnalytics{Since: entry.Since, Until: entry.Until})
+			}
+			merged.Timeseries[i].add(entry)
+		}
+	}
+	sort.SliceStable(merged.Timeseries, func(i, j int) bool {
+		if !merged.Timeseries[i].Since.Equal(merged.Timeseries[j].Since) {
+			return merged.Timeseries[i].Since.Before(merged.Timeseries[j].Since)
+		}
+		return merged.Timeseries[i].Until.Before(merged.Timeseries[j].Until)
+	})
+	return merged
+}
+
+// add adds the counts of b to a, and widens a's time range to cover b's.
+func (a *ZoneAnalytics) add(b ZoneAnalytics) {
+	if a.Since.IsZero() || (!b.Since.IsZero() && b.Since.Before(a.Since)) {
+		a.Since = b.Since
+	}
+	if b.Until.After(a.Until) {
+		a.Until = b.Until
+	}
+
+	a.Requests.All += b.Requests.All
+	a.Requests.Cached += b.Requests.Cached
+	a.Requests.Uncached += b.Requests.Uncached
+	addCounts(&a.Requests.ContentType, b.Requests.ContentType)
+	addCounts(&a.Requests.Country, b.Requests.Country)
+	a.Requests.SSL.Encrypted += b.Requests.SSL.Encrypted
+	a.Requests.SSL.Unencrypted += b.Requests.SSL.Unencrypted
+	addCounts(&a.Requests.HTTPStatus, b.Requests.HTTPStatus)
+
+	a.Bandwidth.All += b.Bandwidth.All
+	a.Bandwidth.Cached += b.Bandwidth.Cached
+	a.Bandwidth.Uncached += b.Bandwidth.Uncached
+	addCounts(&a.Bandwidth.ContentType, b.Bandwidth.ContentType)
+	addCounts(&a.Bandwidth.Country, b.Bandwidth.Country)
+	a.Bandwidth.SSL.Encrypted += b.Bandwidth.SSL.Encrypted
+	a.Bandwidth.SSL.Unencrypted += b.Bandwidth.SSL.Unencrypted
+
+	a.Threats.All += b.Threats.All
+	addCounts(&a.Threats.Country, b.Threats.Country)
+	addCounts(&a.Threats.Type, b.Threats.Type)
+
+	a.Pageviews.All += b.Pageviews.All
+	addCounts(&a.Pageviews.SearchEngines, b.Pageviews.SearchEngines)
+
+	// Unique visitors cannot be added up exactly, as the same visitor may
+	// be counted in more than one zone or window; the sum is an upper bound.
+	a.Uniques.All += b.Uniques.All
+}
+
+func addCounts(dst *map[string]int, src map[string]int) {
+	if len(src) == 0 {
+		return
+	}
+	if *dst == nil {
+		*dst = make(map[string]int, len(src))
+	}
+	for k, v := range src {
+		(*dst)[k] += v
+	}
+}
+
+// zoneAnalyticsCSVHeader names the columns written by WriteCSV.
+var zoneAnalyticsCSVHeader = []string{
+	"zone_id", "since", "until",
+	"requests", "cached_requests", "uncached_requests", "encrypted_requests", "unencrypted_requests",
+	"bandwidth", "cached_bandwidth", "uncached_bandwidth", "encrypted_bandwidth", "unencrypted_bandwidth",
+	"threats", "pageviews", "uniques",
+}
+
+// WriteCSV writes a row for each entry in the time series of each zone, in
+// order of zone ID and then time. The breakdowns by country, status and so
+// on are left out; WriteJSONLines includes them.
+func (r ZoneAnalyticsReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(zoneAnalyticsCSVHeader); err != nil {
+		return err
+	}
+	for _, zoneID := range r.zoneIDs() {
+		for _, a := range r.Zones[zoneID].Timeseries {
+			row := []string{zoneID, a.Since.Format(time.RFC3339), a.Until.Format(time.RFC3339)}
+			for _, n := range []int{
+				a.Requests.All, a.Requests.Cached, a.Requests.Uncached, a.Requests.SSL.Encrypted, a.Requests.SSL.Unencrypted,
+				a.Bandwidth.All, a.Bandwidth.Cached, a.Bandwidth.Uncached, a.Bandwidth.SSL.Encrypted, a.Bandwidth.SSL.Unencrypted,
+				a.Threats.All, a.Pageviews.All, a.Uniques.All,
+			} {
+				row = append(row, strconv.Itoa(n))
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSONLines writes a JSON object for each entry in the time series of
+// each zone, in order of zone ID and then time, one per line. Each object is
+// a ZoneAnalytics with the zone's ID added as zone_id.
+func (r ZoneAnalyticsReport) WriteJSONLines(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, zoneID := range r.zoneIDs() {
+		for _, a := range r.Zones[zoneID].Timeseries {
+			line := struct {
+				ZoneID string `json:"zone_id"`
+				ZoneAnalytics
+			}{zoneID, a}
+			if err := enc.Encode(line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r ZoneAnalyticsReport) zoneIDs() []string {
+	ids := make([]string, 0, len(r.Zones))
+	for id := range r.Zones {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,131 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dailyZoneAnalytics serves a dashboard with a time series of one entry per
+// day of the range requested, each counting one request per zone.
+func dailyZoneAnalytics(t *testing.T, requests *[]string) http.HandlerFunc {
+	var mu sync.Mutex
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		since, err := time.Parse(time.RFC3339, r.URL.Query().Get("since"))
+		assert.NoError(t, err)
+		until, err := time.Parse(time.RFC3339, r.URL.Query().Get("until"))
+		assert.NoError(t, err)
+		mu.Lock()
+		*requests = append(*requests, r.URL.Path+" "+r.URL.Query().Get("since"))
+		mu.Unlock()
+
+		data := ZoneAnalyticsData{}
+		for day := since; day.Before(until); day = day.AddDate(0, 0, 1) {
+			var a ZoneAnalytics
+			a.Since, a.Until = day, day.AddDate(0, 0, 1)
+			a.Requests.All = 1
+			a.Requests.Country = map[string]int{"US": 1}
+			a.Requests.HTTPStatus = map[string]int{"200": 1}
+			a.Bandwidth.All = 100
+			data.Timeseries = append(data.Timeseries, a)
+			data.Totals.add(a)
+		}
+		w.Header().Set("content-type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(zoneAnalyticsDataResponse{
+			Response: Response{Success: true},
+			Result:   data,
+		}))
+	}
+}
+
+func TestZoneAnalyticsRange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	handler := dailyZoneAnalytics(t, &requests)
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/analytics/dashboard", handler)
+	mux.HandleFunc("/zones/9a7806061c88ada191ed06f989cc3dac/analytics/dashboard", handler)
+
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	report, err := client.ZoneAnalyticsRange(
+		[]string{"023e105f4ecef8ad9ca31a8372d0c353", "9a7806061c88ada191ed06f989cc3dac"},
+		ZoneAnalyticsRangeOptions{Since: since, Until: since.AddDate(0, 0, 10), Window: 72 * time.Hour},
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, requests, 8, "10 days in windows of 3 for each of 2 zones")
+
+	zone := report.Zones["023e105f4ecef8ad9ca31a8372d0c353"]
+	if assert.Len(t, zone.Timeseries, 10) {
+		assert.Equal(t, since, zone.Timeseries[0].Since)
+		assert.Equal(t, since.AddDate(0, 0, 9), zone.Timeseries[9].Since)
+	}
+	assert.Equal(t, 10, zone.Totals.Requests.All)
+	assert.Equal(t, since, zone.Totals.Since)
+	assert.Equal(t, since.AddDate(0, 0, 10), zone.Totals.Until)
+
+	totals := report.Totals
+	assert.Equal(t, 20, totals.Totals.Requests.All)
+	assert.Equal(t, 2000, totals.Totals.Bandwidth.All)
+	assert.Equal(t, map[string]int{"US": 20}, totals.Totals.Requests.Country)
+	assert.Equal(t, map[string]int{"200": 20}, totals.Totals.Requests.HTTPStatus)
+	if assert.Len(t, totals.Timeseries, 10) {
+		assert.Equal(t, 2, totals.Timeseries[4].Requests.All)
+		assert.Equal(t, map[string]int{"US": 2}, totals.Timeseries[4].Requests.Country)
+	}
+
+	var csv bytes.Buffer
+	if assert.NoError(t, report.WriteCSV(&csv)) {
+		lines := strings.Split(strings.TrimSpace(csv.String()), "\n")
+		if assert.Len(t, lines, 21) {
+			assert.Equal(t, "zone_id,since,until,requests,cached_requests,uncached_requests,encrypted_requests,unencrypted_requests,bandwidth,cached_bandwidth,uncached_bandwidth,encrypted_bandwidth,unencrypted_bandwidth,threats,pageviews,uniques", lines[0])
+			assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353,2019-03-01T00:00:00Z,2019-03-02T00:00:00Z,1,0,0,0,0,100,0,0,0,0,0,0,0", lines[1])
+			assert.True(t, strings.HasPrefix(lines[11], "9a7806061c88ada191ed06f989cc3dac,"))
+		}
+	}
+
+	var jsonl bytes.Buffer
+	if assert.NoError(t, report.WriteJSONLines(&jsonl)) {
+		lines := strings.Split(strings.TrimSpace(jsonl.String()), "\n")
+		if assert.Len(t, lines, 20) {
+			var line map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(lines[0]), &line))
+			assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", line["zone_id"])
+			assert.Equal(t, "2019-03-01T00:00:00Z", line["since"])
+			assert.Equal(t, map[string]interface{}{"US": float64(1)}, line["requests"].(map[string]interface{})["country"])
+		}
+	}
+}
+
+func TestZoneAnalyticsRange_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/analytics/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"success": false, "errors": [{"code": 1010, "message": "Time range is too long"}], "messages": [], "result": null}`))
+	})
+
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.ZoneAnalyticsRange([]string{"023e105f4ecef8ad9ca31a8372d0c353"}, ZoneAnalyticsRangeOptions{Since: since, Until: since.AddDate(0, 1, 0)})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "could not fetch analytics of zone 023e105f4ecef8ad9ca31a8372d0c353 from ")
+		assert.Contains(t, err.Error(), "Time range is too long")
+	}
+
+	_, err = client.ZoneAnalyticsRange([]string{"023e105f4ecef8ad9ca31a8372d0c353"}, ZoneAnalyticsRangeOptions{Since: since, Until: since})
+	assert.EqualError(t, err, "until must be after since")
+
+	_, err = client.ZoneAnalyticsRange([]string{"023e105f4ecef8ad9ca31a8372d0c353"}, ZoneAnalyticsRangeOptions{Until: since})
+	assert.EqualError(t, err, "since is required")
+}
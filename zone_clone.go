@@ -0,0 +1,473 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneCloneKind is a kind of configuration copied by CloneZoneConfig.
+type ZoneCloneKind string
+
+// The kinds of configuration copied by CloneZoneConfig, in the order they
+// are copied.
+const (
+	ZoneCloneSettings       ZoneCloneKind = "settings"
+	ZoneClonePageRules      ZoneCloneKind = "page_rules"
+	ZoneCloneFirewallRules  ZoneCloneKind = "firewall_rules"
+	ZoneCloneRateLimits     ZoneCloneKind = "rate_limits"
+	ZoneCloneLockdowns      ZoneCloneKind = "zone_lockdowns"
+	ZoneCloneUserAgentRules ZoneCloneKind = "user_agent_rules"
+	ZoneCloneWorkerRoutes   ZoneCloneKind = "worker_routes"
+)
+
+// ZoneCloneOptions configures CloneZoneConfig.
+type ZoneCloneOptions struct {
+	// DryRun reports what would be copied without changing the destination
+	// zone.
+	DryRun bool
+	// Exclude lists the kinds of configuration not to copy.
+	Exclude []ZoneCloneKind
+	// Hostnames maps further hostnames to rewrite, beyond the source zone's
+	// name, which is always rewritten to the destination zone's.
+	Hostnames map[string]string
+}
+
+// ZoneCloneItem is a setting changed or a resource created by
+// CloneZoneConfig.
+type ZoneCloneItem struct {
+	Kind        ZoneCloneKind
+	Description string
+	// ID is the ID of the created resource. It is empty for settings, in a
+	// dry run, and when the item failed.
+	ID  string
+	Err error
+}
+
+// ZoneCloneReport lists what CloneZoneConfig copied, or would copy in a dry
+// run.
+type ZoneCloneReport struct {
+	DryRun bool
+	Items  []ZoneCloneItem
+}
+
+// Failed returns the items that could not be copied.
+func (r ZoneCloneReport) Failed() []ZoneCloneItem {
+	var failed []ZoneCloneItem
+	for _, item := range r.Items {
+		if item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// String returns a line per item, marking those that failed.
+func (r ZoneCloneReport) String() string {
+	var b strings.Builder
+	for _, item := range r.Items {
+		fmt.Fprintf(&b, "%s: %s", item.Kind, item.Description)
+		if item.Err != nil {
+			fmt.Fprintf(&b, " (failed: %s)", item.Err)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// zoneConfig is the configuration of a zone read by CloneZoneConfig.
+type zoneConfig struct {
+	settings       []ZoneSetting
+	pageRules      []PageRule
+	firewallRules  []FirewallRule
+	rateLimits     []RateLimit
+	lockdowns      []ZoneLockdown
+	userAgentRules []UserAgentRule
+	workerRoutes   []WorkerRoute
+}
+
+// CloneZoneConfig copies the settings, page rules, firewall rules, rate
+// limits, zone lockdowns, User-Agent blocking rules and Workers routes of the
+// zone srcZoneID to the zone dstZoneID. Hostnames in rule targets, filter
+// expressions, URLs and route patterns are rewritten from the source zone's
+// name to the destination's, and as given by opts.Hostnames.
+//
+// Settings are only changed where they differ. Everything else is created
+// in the destination zone alongside what is already there, so cloning into
+// a zone twice creates duplicates. All of the source configuration is read
+// before anything is changed; after that, a failure to copy one item does
+// not stop the others, and the error summarises the failures.
+func (api *API) CloneZoneConfig(srcZoneID, dstZoneID string, opts ZoneCloneOptions) (ZoneCloneReport, error) {
+	return api.CloneZoneConfigContext(context.TODO(), srcZoneID, dstZoneID, opts)
+}
+
+// CloneZoneConfigContext is like CloneZoneConfig but takes a context.
+func (api *API) CloneZoneConfigContext(ctx context.Context, srcZoneID, dstZoneID string, opts ZoneCloneOptions) (ZoneCloneReport, error) {
+	report := ZoneCloneReport{DryRun: opts.DryRun}
+	excluded := make(map[ZoneCloneKind]bool)
+	for _, kind := range opts.Exclude {
+		excluded[kind] = true
+	}
+
+	src, err := api.ZoneDetailsContext(ctx, srcZoneID)
+	if err != nil {
+		return report, errors.Wrap(err, "could not read source zone")
+	}
+	dst, err := api.ZoneDetailsContext(ctx, dstZoneID)
+	if err != nil {
+		return report, errors.Wrap(err, "could not read destination zone")
+	}
+	rewrite := newHostnameRewriter(src.Name, dst.Name, opts.Hostnames)
+
+	cfg, err := api.readZoneConfig(ctx, srcZoneID, excluded)
+	if err != nil {
+		return report, err
+	}
+
+	add := func(kind ZoneCloneKind, description string, create func() (string, error)) {
+		item := ZoneCloneItem{Kind: kind, Description: description}
+		if !opts.DryRun {
+			item.ID, item.Err = create()
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	if !excluded[ZoneCloneSettings] {
+		if err := api.cloneZoneSettings(ctx, dstZoneID, cfg.settings, &report); err != nil {
+			return report, err
+		}
+	}
+
+	for _, rule := range cfg.pageRules {
+		rule.ID = ""
+		rule.CreatedOn, rule.ModifiedOn = time.Time{}, time.Time{}
+		targets := make([]PageRuleTarget, len(rule.Targets))
+		var urls []string
+		for i, t := range rule.Targets {
+			t.Constraint.Value = rewrite.string(t.Constraint.Value)
+			targets[i] = t
+			urls = append(urls, t.Constraint.Value)
+		}
+		rule.Targets = targets
+		actions := make([]PageRuleAction, len(rule.Actions))
+		var ids []string
+		for i, a := range rule.Actions {
+			a.Value = rewrite.value(a.Value)
+			actions[i] = a
+			ids = append(ids, a.ID)
+		}
+		rule.Actions = actions
+
+		rule := rule
+		add(ZoneClonePageRules, fmt.Sprintf("%s (%s)", strings.Join(urls, ", "), strings.Join(ids, ", ")), func() (string, error) {
+			created, err := api.CreatePageRuleContext(ctx, dstZoneID, rule)
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		})
+	}
+
+	for _, rule := range cfg.firewallRules {
+		rule.ID = ""
+		rule.Filter.ID = ""
+		rule.Filter.Expression = rewrite.string(rule.Filter.Expression)
+
+		rule := rule
+		add(ZoneCloneFirewallRules, rule.Action+" "+rule.Filter.Expression, func() (string, error) {
+			created, err := api.CreateFirewallRulesContext(ctx, dstZoneID, []FirewallRule{rule})
+			if err != nil {
+				return "", err
+			}
+			if len(created) == 0 {
+				return "", errors.New("no firewall rule was created")
+			}
+			return created[0].ID, nil
+		})
+	}
+
+	for _, limit := range cfg.rateLimits {
+		limit.ID = ""
+		limit.Match.Request.URLPattern = rewrite.string(limit.Match.Request.URLPattern)
+		bypass := make([]RateLimitKeyValue, len(limit.Bypass))
+		for i, kv := range limit.Bypass {
+			if kv.Name == "url" {
+				kv.Value = rewrite.string(kv.Value)
+			}
+			bypass[i] = kv
+		}
+		limit.Bypass = bypass
+
+		limit := limit
+		add(ZoneCloneRateLimits, fmt.Sprintf("%s %d requests per %ds", limit.Match.Request.URLPattern, limit.Threshold, limit.Period), func() (string, error) {
+			created, err := api.CreateRateLimitContext(ctx, dstZoneID, limit)
+			return created.ID, err
+		})
+	}
+
+	for _, ld := range cfg.lockdowns {
+		ld.ID = ""
+		urls := make([]string, len(ld.URLs))
+		for i, u := range ld.URLs {
+			urls[i] = rewrite.string(u)
+		}
+		ld.URLs = urls
+
+		ld := ld
+		add(ZoneCloneLockdowns, strings.Join(ld.URLs, ", "), func() (string, error) {
+			created, err := api.CreateZoneLockdownContext(ctx, dstZoneID, ld)
+			if err != nil {
+				return "", err
+			}
+			return created.Result.ID, nil
+		})
+	}
+
+	for _, rule := range cfg.userAgentRules {
+		rule.ID = ""
+
+		rule := rule
+		add(ZoneCloneUserAgentRules, rule.Mode+" "+rule.Configuration.Value, func() (string, error) {
+			created, err := api.CreateUserAgentRuleContext(ctx, dstZoneID, rule)
+			if err != nil {
+				return "", err
+			}
+			return created.Result.ID, nil
+		})
+	}
+
+	for _, route := range cfg.workerRoutes {
+		route.ID = ""
+		route.Pattern = rewrite.string(route.Pattern)
+
+		route := route
+		description := route.Pattern
+		if route.Script != "" {
+			description += " -> " + route.Script
+		}
+		add(ZoneCloneWorkerRoutes, description, func() (string, error) {
+			created, err := api.CreateWorkerRouteContext(ctx, dstZoneID, route)
+			return created.WorkerRoute.ID, err
+		})
+	}
+
+	if failed := report.Failed(); len(failed) > 0 {
+		return report, errors.Errorf("%d of %d items could not be copied", len(failed), len(report.Items))
+	}
+	return report, nil
+}
+
+// readZoneConfig reads the configuration of a zone that is not excluded.
+func (api *API) readZoneConfig(ctx context.Context, zoneID string, excluded map[ZoneCloneKind]bool) (zoneConfig, error) {
+	var cfg zoneConfig
+	var err error
+	if !excluded[ZoneCloneSettings] {
+		res, err := api.ZoneSettingsContext(ctx, zoneID)
+		if err != nil {
+			return cfg, errors.Wrap(err, "could not read zone settings")
+		}
+		cfg.settings = res.Result
+	}
+	if !excluded[ZoneClonePageRules] {
+		if cfg.pageRules, err = api.ListPageRulesContext(ctx, zoneID); err != nil {
+			return cfg, errors.Wrap(err, "could not read page rules")
+		}
+		sort.SliceStable(cfg.pageRules, func(i, j int) bool {
+			return cfg.pageRules[i].Priority < cfg.pageRules[j].Priority
+		})
+	}
+	if !excluded[ZoneCloneFirewallRules] {
+		if cfg.firewallRules, err = api.FirewallRulesIter(ctx, zoneID, PaginationOptions{}).All(1); err != nil {
+			return cfg, errors.Wrap(err, "could not read firewall rules")
+		}
+	}
+	if !excluded[ZoneCloneRateLimits] {
+		if cfg.rateLimits, err = api.ListAllRateLimitsContext(ctx, zoneID); err != nil {
+			return cfg, errors.Wrap(err, "could not read rate limits")
+		}
+	}
+	if !excluded[ZoneCloneLockdowns] {
+		if cfg.lockdowns, err = api.ListZoneLockdownsIter(ctx, zoneID).All(1); err != nil {
+			return cfg, errors.Wrap(err, "could not read zone lockdowns")
+		}
+	}
+	if !excluded[ZoneCloneUserAgentRules] {
+		if cfg.userAgentRules, err = api.ListUserAgentRulesIter(ctx, zoneID).All(1); err != nil {
+			return cfg, errors.Wrap(err, "could not read User-Agent rules")
+		}
+	}
+	if !excluded[ZoneCloneWorkerRoutes] {
+		res, err := api.ListWorkerRoutesContext(ctx, zoneID)
+		if err != nil {
+			return cfg, errors.Wrap(err, "could not read Workers routes")
+		}
+		cfg.workerRoutes = res.Routes
+	}
+	return cfg, nil
+}
+
+// cloneZoneSettings changes the settings of the destination zone that are
+// editable in both zones and differ, in one request, adding them to report.
+// Settings that cannot be edited in the destination zone are reported as
+// failed.
+func (api *API) cloneZoneSettings(ctx context.Context, dstZoneID string, settings []ZoneSetting, report *ZoneCloneReport) error {
+	var editable []ZoneSetting
+	for _, setting := range settings {
+		if setting.Editable {
+			editable = append(editable, setting)
+		}
+	}
+	desired, err := NewZoneSettingsSnapshot(editable)
+	if err != nil {
+		return err
+	}
+	res, err := api.ZoneSettingsContext(ctx, dstZoneID)
+	if err != nil {
+		return errors.Wrap(err, "could not read destination zone settings")
+	}
+	current, err := NewZoneSettingsSnapshot(res.Result)
+	if err != nil {
+		return err
+	}
+	dstEditable := make(map[string]bool, len(res.Result))
+	for _, setting := range res.Result {
+		dstEditable[setting.ID] = setting.Editable
+	}
+
+	first := len(report.Items)
+	var items []ZoneSetting
+	for _, c := range current.Diff(desired) {
+		item := ZoneCloneItem{Kind: ZoneCloneSettings, Description: c.String()}
+		if e, ok := dstEditable[c.ID]; ok && !e {
+			item.Err = errors.New("setting is not editable in the destination zone")
+		} else {
+			items = append(items, ZoneSetting{ID: c.ID, Value: c.To})
+		}
+		report.Items = append(report.Items, item)
+	}
+	if report.DryRun || len(items) == 0 {
+		return nil
+	}
+	if _, err := api.UpdateZoneSettingsContext(ctx, dstZoneID, items); err != nil {
+		for i := first; i < len(report.Items); i++ {
+			if report.Items[i].Err == nil {
+				report.Items[i].Err = err
+			}
+		}
+	}
+	return nil
+}
+
+// hostnameRewriter replaces hostnames in configuration copied between zones.
+type hostnameRewriter struct {
+	from []string
+	to   map[string]string
+}
+
+func newHostnameRewriter(srcZone, dstZone string, extra map[string]string) hostnameRewriter {
+	r := hostnameRewriter{to: make(map[string]string)}
+	add := func(from, to string) {
+		from = strings.ToLower(strings.TrimSuffix(from, "."))
+		if from == "" {
+			return
+		}
+		if _, ok := r.to[from]; !ok {
+			r.from = append(r.from, from)
+		}
+		r.to[from] = strings.TrimSuffix(to, ".")
+	}
+	for from, to := range extra {
+		add(from, to)
+	}
+	if srcZone != dstZone {
+		add(srcZone, dstZone)
+	}
+	// Try longer names first, so that a mapping for a subdomain wins over
+	// one for the zone.
+	sort.Slice(r.from, func(i, j int) bool {
+		if len(r.from[i]) != len(r.from[j]) {
+			return len(r.from[i]) > len(r.from[j])
+		}
+		return r.from[i] < r.from[j]
+	})
+	return r
+}
+
+// string replaces the hostnames in s. A hostname is replaced wherever it
+// appears as a whole name or as the suffix of a longer one, so "example.com"
+// is replaced in "www.example.com" but not in "myexample.com" or
+// "example.com.au".
+//
+// s is rewritten in a single pass: where several names match, the longest
+// wins, and replacements are never rewritten again.
+func (r hostnameRewriter) string(s string) string {
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(s); i++ {
+		if i > 0 && isHostnameChar(s[i-1]) {
+			continue
+		}
+		for _, from := range r.from {
+			end := i + len(from)
+			if end <= len(s) && strings.EqualFold(s[i:end], from) && hostnameBoundary(s, i, end) {
+				b.WriteString(s[last:i])
+				b.WriteString(r.to[from])
+				last = end
+				i = end - 1
+				break
+			}
+		}
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// value replaces the hostnames in the strings within v, such as a page rule
+// action value.
+func (r hostnameRewriter) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return r.string(v)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = r.value(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = r.value(e)
+		}
+		return s
+	}
+	return v
+}
+
+// hostnameBoundary reports whether s[start:end] is a whole hostname or the
+// suffix of one, rather than part of a longer label or name.
+func hostnameBoundary(s string, start, end int) bool {
+	if start > 0 && isHostnameChar(s[start-1]) {
+		return false
+	}
+	if end < len(s) {
+		if isHostnameChar(s[end]) {
+			return false
+		}
+		if s[end] == '.' && end+1 < len(s) && isHostnameChar(s[end+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostnameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
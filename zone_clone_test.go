@@ -0,0 +1,213 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	cloneSrcZoneID = "023e105f4ecef8ad9ca31a8372d0c353"
+	cloneDstZoneID = "9a7806061c88ada191ed06f989cc3dac"
+)
+
+// setupZoneClone serves the configuration of a template zone, example.com,
+// and an empty destination zone, example.net. The bodies of the requests
+// made to the destination zone are returned by path.
+func setupZoneClone(t *testing.T) map[string][]string {
+	created := make(map[string][]string)
+	respond := func(w http.ResponseWriter, result string) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 100, "count": 1, "total_count": 1, "total_pages": 1}}`, result)
+	}
+	handle := func(path, list, create string) {
+		mux.HandleFunc("/zones/"+cloneSrcZoneID+path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method, "the source zone must not be changed")
+			respond(w, list)
+		})
+		mux.HandleFunc("/zones/"+cloneDstZoneID+path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				respond(w, "[]")
+				return
+			}
+			b, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			created[r.Method+" "+path] = append(created[r.Method+" "+path], string(b))
+			respond(w, create)
+		})
+	}
+
+	mux.HandleFunc("/zones/"+cloneSrcZoneID, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, `{"id": "`+cloneSrcZoneID+`", "name": "example.com"}`)
+	})
+	mux.HandleFunc("/zones/"+cloneDstZoneID, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, `{"id": "`+cloneDstZoneID+`", "name": "example.net"}`)
+	})
+	mux.HandleFunc("/zones/"+cloneSrcZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, `[
+			{"id": "always_online", "value": "on", "editable": true},
+			{"id": "min_tls_version", "value": "1.2", "editable": true},
+			{"id": "ssl", "value": "strict", "editable": true},
+			{"id": "advanced_ddos", "value": "on", "editable": false}
+		]`)
+	})
+	mux.HandleFunc("/zones/"+cloneDstZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			respond(w, `[
+				{"id": "always_online", "value": "on", "editable": true},
+				{"id": "min_tls_version", "value": "1.0", "editable": true},
+				{"id": "ssl", "value": "flexible", "editable": false},
+				{"id": "advanced_ddos", "value": "off", "editable": false}
+			]`)
+			return
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		created[r.Method+" /settings"] = append(created[r.Method+" /settings"], string(b))
+		respond(w, "[]")
+	})
+
+	handle("/pagerules", `[{
+		"id": "9a7806061c88ada191ed06f989cc3dac",
+		"targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*.example.com/old/*"}}],
+		"actions": [{"id": "forwarding_url", "value": {"url": "https://www.example.com/new/$2", "status_code": 301}}],
+		"priority": 1, "status": "active"
+	}]`, `{"id": "f3c7d4a1e2b9d8c7f6e5d4c3b2a19080"}`)
+	handle("/firewall/rules", `[{
+		"id": "372e67954025e0ba6aaa6d586b9e0b60", "action": "block", "description": "admin",
+		"filter": {"id": "372e67954025e0ba6aaa6d586b9e0b61", "expression": "http.host eq \"admin.example.com\" and not http.host eq \"myexample.com\""}
+	}]`, `[{"id": "372e67954025e0ba6aaa6d586b9e0b62"}]`)
+	handle("/rate_limits", `[{
+		"id": "372e67954025e0ba6aaa6d586b9e0b63", "threshold": 60, "period": 60,
+		"match": {"request": {"url": "api.example.com/*"}},
+		"bypass": [{"name": "url", "value": "api.example.com/health"}]
+	}]`, `{"id": "372e67954025e0ba6aaa6d586b9e0b64"}`)
+	handle("/firewall/lockdowns", `[{
+		"id": "372e67954025e0ba6aaa6d586b9e0b65", "urls": ["example.com/admin*", "example.com.au/admin*"],
+		"configurations": [{"target": "ip", "value": "198.51.100.4"}]
+	}]`, `{"id": "372e67954025e0ba6aaa6d586b9e0b66"}`)
+	handle("/firewall/ua_rules", `[{
+		"id": "372e67954025e0ba6aaa6d586b9e0b67", "mode": "block",
+		"configuration": {"target": "ua", "value": "BadBot/1.0"}
+	}]`, `{"id": "372e67954025e0ba6aaa6d586b9e0b68"}`)
+	handle("/workers/filters", `[{
+		"id": "372e67954025e0ba6aaa6d586b9e0b69", "pattern": "static.example.com/*", "enabled": true
+	}]`, `{"id": "372e67954025e0ba6aaa6d586b9e0b70"}`)
+
+	return created
+}
+
+func TestCloneZoneConfig(t *testing.T) {
+	setup()
+	defer teardown()
+	created := setupZoneClone(t)
+
+	report, err := client.CloneZoneConfig(cloneSrcZoneID, cloneDstZoneID, ZoneCloneOptions{
+		Hostnames: map[string]string{"static.example.com": "cdn.example.net"},
+	})
+	assert.EqualError(t, err, "1 of 8 items could not be copied")
+	assert.Equal(t, `settings: min_tls_version: "1.0" -> "1.2"
+settings: ssl: "flexible" -> "strict" (failed: setting is not editable in the destination zone)
+page_rules: *.example.net/old/* (forwarding_url)
+firewall_rules: block http.host eq "admin.example.net" and not http.host eq "myexample.com"
+rate_limits: api.example.net/* 60 requests per 60s
+zone_lockdowns: example.net/admin*, example.com.au/admin*
+user_agent_rules: block BadBot/1.0
+worker_routes: cdn.example.net/*
+`, report.String())
+	assert.Equal(t, "f3c7d4a1e2b9d8c7f6e5d4c3b2a19080", report.Items[2].ID)
+	assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b62", report.Items[3].ID)
+	assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b70", report.Items[7].ID)
+
+	if assert.Len(t, created["PATCH /settings"], 1) {
+		var body struct {
+			Items []ZoneSetting `json:"items"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(created["PATCH /settings"][0]), &body))
+		if assert.Len(t, body.Items, 1) {
+			assert.Equal(t, "min_tls_version", body.Items[0].ID)
+			assert.Equal(t, "1.2", body.Items[0].Value)
+		}
+	}
+	if assert.Len(t, created["POST /pagerules"], 1) {
+		var rule map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(created["POST /pagerules"][0]), &rule))
+		assert.NotContains(t, rule, "id")
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"id":    "forwarding_url",
+			"value": map[string]interface{}{"url": "https://www.example.net/new/$2", "status_code": float64(301)},
+		}}, rule["actions"])
+	}
+	if assert.Len(t, created["POST /firewall/rules"], 1) {
+		var rules []FirewallRule
+		assert.NoError(t, json.Unmarshal([]byte(created["POST /firewall/rules"][0]), &rules))
+		if assert.Len(t, rules, 1) {
+			assert.Empty(t, rules[0].ID)
+			assert.Empty(t, rules[0].Filter.ID)
+		}
+	}
+	if assert.Len(t, created["POST /rate_limits"], 1) {
+		var limit RateLimit
+		assert.NoError(t, json.Unmarshal([]byte(created["POST /rate_limits"][0]), &limit))
+		assert.Equal(t, []RateLimitKeyValue{{Name: "url", Value: "api.example.net/health"}}, limit.Bypass)
+	}
+	for _, path := range []string{"POST /firewall/lockdowns", "POST /firewall/ua_rules", "POST /workers/filters"} {
+		assert.Len(t, created[path], 1, path)
+	}
+}
+
+func TestCloneZoneConfig_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+	created := setupZoneClone(t)
+
+	report, err := client.CloneZoneConfig(cloneSrcZoneID, cloneDstZoneID, ZoneCloneOptions{
+		DryRun:  true,
+		Exclude: []ZoneCloneKind{ZoneCloneSettings, ZoneCloneWorkerRoutes},
+	})
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	if assert.Len(t, report.Items, 5) {
+		assert.Equal(t, ZoneClonePageRules, report.Items[0].Kind)
+		assert.Empty(t, report.Items[0].ID)
+	}
+	assert.Empty(t, created)
+}
+
+func TestHostnameRewriter(t *testing.T) {
+	r := newHostnameRewriter("example.com", "example.net", map[string]string{"www.example.com": "example.org"})
+	for in, want := range map[string]string{
+		"example.com":                  "example.net",
+		"EXAMPLE.COM/path":             "example.net/path",
+		"*.example.com/*":              "*.example.net/*",
+		"www.example.com/a":            "example.org/a",
+		"api.www.example.com":          "api.example.org",
+		"myexample.com":                "myexample.com",
+		"example.com.au":               "example.com.au",
+		"example.company":              "example.company",
+		"end of sentence example.com.": "end of sentence example.net.",
+	} {
+		assert.Equal(t, want, r.string(in), in)
+	}
+
+	// Replacements are not rewritten again, even when they contain a name
+	// that is being replaced.
+	r = newHostnameRewriter("example.com", "new.example.com", nil)
+	assert.Equal(t, "www.new.example.com", r.string("www.example.com"))
+	assert.Equal(t, "new.example.com/a new.example.com", r.string("example.com/a example.com"))
+
+	r = newHostnameRewriter("example.com", "example.org", map[string]string{"a.example.com": "b.example.com"})
+	assert.Equal(t, "b.example.com", r.string("a.example.com"))
+	assert.Equal(t, "x.b.example.com www.example.org", r.string("x.a.example.com www.example.com"))
+
+	// Text whose lower case has a different length does not throw out the
+	// positions of the names after it.
+	r = newHostnameRewriter("example.com", "example.net", nil)
+	assert.Equal(t, "ẞẞẞẞẞẞ example.net", r.string("ẞẞẞẞẞẞ example.com"))
+	assert.Equal(t, "ȺȺȺ example.net ȺȺȺ", r.string("ȺȺȺ example.com ȺȺȺ"))
+	assert.Equal(t, "Straße example.net/ẞ", r.string("Straße EXAMPLE.com/ẞ"))
+}
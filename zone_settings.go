@@ -0,0 +1,318 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneSettingsSnapshot holds the values of a zone's settings, with a typed
+// field for each known setting. A snapshot can be taken from a zone with
+// SnapshotZoneSettings, stored as JSON or YAML, compared with another with
+// Diff, and enforced on a zone with ApplyZoneSettings.
+//
+// Empty fields are settings the snapshot does not include, which are left
+// alone when it is applied. Most settings are "on" or "off"; the others are
+// noted below. Integer and object settings are pointers so that their zero
+// values can be included.
+type ZoneSettingsSnapshot struct {
+	ZeroRTT                 string                     `json:"0rtt,omitempty" yaml:"0rtt,omitempty"`
+	AdvancedDDoS            string                     `json:"advanced_ddos,omitempty" yaml:"advanced_ddos,omitempty"`
+	AlwaysOnline            string                     `json:"always_online,omitempty" yaml:"always_online,omitempty"`
+	AlwaysUseHTTPS          string                     `json:"always_use_https,omitempty" yaml:"always_use_https,omitempty"`
+	AutomaticHTTPSRewrites  string                     `json:"automatic_https_rewrites,omitempty" yaml:"automatic_https_rewrites,omitempty"`
+	Brotli                  string                     `json:"brotli,omitempty" yaml:"brotli,omitempty"`
+	BrowserCacheTTL         *int                       `json:"browser_cache_ttl,omitempty" yaml:"browser_cache_ttl,omitempty"` // seconds; 0 respects origin headers
+	BrowserCheck            string                     `json:"browser_check,omitempty" yaml:"browser_check,omitempty"`
+	CacheLevel              string                     `json:"cache_level,omitempty" yaml:"cache_level,omitempty"`     // "basic", "simplified" or "aggressive"
+	ChallengeTTL            *int                       `json:"challenge_ttl,omitempty" yaml:"challenge_ttl,omitempty"` // seconds
+	Ciphers                 []string                   `json:"ciphers,omitempty" yaml:"ciphers,omitempty"`
+	CNAMEFlattening         string                     `json:"cname_flattening,omitempty" yaml:"cname_flattening,omitempty"` // "flatten_at_root" or "flatten_all"
+	DevelopmentMode         string                     `json:"development_mode,omitempty" yaml:"development_mode,omitempty"`
+	EdgeCacheTTL            *int                       `json:"edge_cache_ttl,omitempty" yaml:"edge_cache_ttl,omitempty"` // seconds
+	EmailObfuscation        string                     `json:"email_obfuscation,omitempty" yaml:"email_obfuscation,omitempty"`
+	H2Prioritization        string                     `json:"h2_prioritization,omitempty" yaml:"h2_prioritization,omitempty"` // "on", "off" or "custom"
+	HotlinkProtection       string                     `json:"hotlink_protection,omitempty" yaml:"hotlink_protection,omitempty"`
+	HTTP2                   string                     `json:"http2,omitempty" yaml:"http2,omitempty"`
+	HTTP3                   string                     `json:"http3,omitempty" yaml:"http3,omitempty"`
+	ImageResizing           string                     `json:"image_resizing,omitempty" yaml:"image_resizing,omitempty"`
+	IPGeolocation           string                     `json:"ip_geolocation,omitempty" yaml:"ip_geolocation,omitempty"`
+	IPv6                    string                     `json:"ipv6,omitempty" yaml:"ipv6,omitempty"`
+	MaxUpload               *int                       `json:"max_upload,omitempty" yaml:"max_upload,omitempty"`           // megabytes
+	MinTLSVersion           string                     `json:"min_tls_version,omitempty" yaml:"min_tls_version,omitempty"` // "1.0", "1.1", "1.2" or "1.3"
+	Minify                  *ZoneSettingMinify         `json:"minify,omitempty" yaml:"minify,omitempty"`
+	Mirage                  string                     `json:"mirage,omitempty" yaml:"mirage,omitempty"`
+	MobileRedirect          *ZoneSettingMobileRedirect `json:"mobile_redirect,omitempty" yaml:"mobile_redirect,omitempty"`
+	OpportunisticEncryption string                     `json:"opportunistic_encryption,omitempty" yaml:"opportunistic_encryption,omitempty"`
+	OpportunisticOnion      string                     `json:"opportunistic_onion,omitempty" yaml:"opportunistic_onion,omitempty"`
+	OrangeToOrange          string                     `json:"orange_to_orange,omitempty" yaml:"orange_to_orange,omitempty"`
+	OriginErrorPagePassThru string                     `json:"origin_error_page_pass_thru,omitempty" yaml:"origin_error_page_pass_thru,omitempty"`
+	Polish                  string                     `json:"polish,omitempty" yaml:"polish,omitempty"` // "off", "lossless" or "lossy"
+	PrefetchPreload         string                     `json:"prefetch_preload,omitempty" yaml:"prefetch_preload,omitempty"`
+	PrivacyPass             string                     `json:"privacy_pass,omitempty" yaml:"privacy_pass,omitempty"`
+	PseudoIPv4              string                     `json:"pseudo_ipv4,omitempty" yaml:"pseudo_ipv4,omitempty"` // "off", "add_header" or "overwrite_header"
+	ResponseBuffering       string                     `json:"response_buffering,omitempty" yaml:"response_buffering,omitempty"`
+	RocketLoader            string                     `json:"rocket_loader,omitempty" yaml:"rocket_loader,omitempty"`
+	SecurityHeader          *ZoneSettingSecurityHeader `json:"security_header,omitempty" yaml:"security_header,omitempty"`
+	SecurityLevel           string                     `json:"security_level,omitempty" yaml:"security_level,omitempty"` // "essentially_off", "low", "medium", "high" or "under_attack"
+	ServerSideExclude       string                     `json:"server_side_exclude,omitempty" yaml:"server_side_exclude,omitempty"`
+	SortQueryStringForCache string                     `json:"sort_query_string_for_cache,omitempty" yaml:"sort_query_string_for_cache,omitempty"`
+	SSL                     string                     `json:"ssl,omitempty" yaml:"ssl,omitempty"`         // "off", "flexible", "full" or "strict"
+	TLS13                   string                     `json:"tls_1_3,omitempty" yaml:"tls_1_3,omitempty"` // "on", "off" or "zrt"
+	TLSClientAuth           string                     `json:"tls_client_auth,omitempty" yaml:"tls_client_auth,omitempty"`
+	TrueClientIPHeader      string                     `json:"true_client_ip_header,omitempty" yaml:"true_client_ip_header,omitempty"`
+	WAF                     string                     `json:"waf,omitempty" yaml:"waf,omitempty"`
+	WebP                    string                     `json:"webp,omitempty" yaml:"webp,omitempty"`
+	WebSockets              string                     `json:"websockets,omitempty" yaml:"websockets,omitempty"`
+
+	// Other holds the settings without a field above, by ID.
+	Other map[string]interface{} `json:"other,omitempty" yaml:"other,omitempty"`
+}
+
+// ZoneSettingMinify is the value of the minify setting.
+type ZoneSettingMinify struct {
+	CSS  string `json:"css" yaml:"css"`
+	HTML string `json:"html" yaml:"html"`
+	JS   string `json:"js" yaml:"js"`
+}
+
+// ZoneSettingMobileRedirect is the value of the mobile_redirect setting.
+type ZoneSettingMobileRedirect struct {
+	Status          string `json:"status" yaml:"status"`
+	MobileSubdomain string `json:"mobile_subdomain" yaml:"mobile_subdomain"`
+	StripURI        bool   `json:"strip_uri" yaml:"strip_uri"`
+}
+
+// ZoneSettingSecurityHeader is the value of the security_header setting.
+type ZoneSettingSecurityHeader struct {
+	StrictTransportSecurity ZoneSettingHSTS `json:"strict_transport_security" yaml:"strict_transport_security"`
+}
+
+// ZoneSettingHSTS configures the Strict-Transport-Security header. MaxAge
+// is in seconds.
+type ZoneSettingHSTS struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	MaxAge            int  `json:"max_age" yaml:"max_age"`
+	IncludeSubdomains bool `json:"include_subdomains" yaml:"include_subdomains"`
+	Preload           bool `json:"preload" yaml:"preload"`
+	NoSniff           bool `json:"nosniff" yaml:"nosniff"`
+}
+
+// knownZoneSettings holds the IDs of the settings with a field in
+// ZoneSettingsSnapshot.
+var knownZoneSettings = func() map[string]bool {
+	known := make(map[string]bool)
+	t := reflect.TypeOf(ZoneSettingsSnapshot{})
+	for i := 0; i < t.NumField(); i++ {
+		id := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if id != "other" {
+			known[id] = true
+		}
+	}
+	return known
+}()
+
+// NewZoneSettingsSnapshot builds a snapshot from settings, such as those
+// returned by ZoneSettings.
+func NewZoneSettingsSnapshot(settings []ZoneSetting) (ZoneSettingsSnapshot, error) {
+	known := make(map[string]interface{})
+	var snapshot ZoneSettingsSnapshot
+	for _, setting := range settings {
+		if knownZoneSettings[setting.ID] {
+			known[setting.ID] = setting.Value
+			continue
+		}
+		if snapshot.Other == nil {
+			snapshot.Other = make(map[string]interface{})
+		}
+		snapshot.Other[setting.ID] = setting.Value
+	}
+	b, err := json.Marshal(known)
+	if err != nil {
+		return ZoneSettingsSnapshot{}, err
+	}
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return ZoneSettingsSnapshot{}, errors.Wrap(err, "unexpected zone setting value")
+	}
+	return snapshot, nil
+}
+
+// Settings returns the settings included in the snapshot, ordered by ID,
+// in the form taken by UpdateZoneSettings.
+func (s ZoneSettingsSnapshot) Settings() []ZoneSetting {
+	values := s.values()
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	settings := make([]ZoneSetting, 0, len(ids))
+	for _, id := range ids {
+		settings = append(settings, ZoneSetting{ID: id, Value: values[id]})
+	}
+	return settings
+}
+
+// values returns the settings included in the snapshot by ID, with values
+// decoded from JSON so that equal values compare equal.
+func (s ZoneSettingsSnapshot) values() map[string]interface{} {
+	other := s.Other
+	s.Other = nil
+	b, err := json.Marshal(s)
+	if err != nil {
+		// Every field of a snapshot can be marshalled.
+		panic(err)
+	}
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(b, &values); err != nil {
+		panic(err)
+	}
+	for id, v := range other {
+		b, err := json.Marshal(stringKeys(v))
+		if err != nil {
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(b, &decoded); err == nil {
+			values[id] = decoded
+		}
+	}
+	return values
+}
+
+// stringKeys converts the map[interface{}]interface{} values produced by
+// YAML decoding, which cannot be marshalled as JSON, to map[string]interface{}.
+func stringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = stringKeys(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = stringKeys(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = stringKeys(e)
+		}
+		return s
+	}
+	return v
+}
+
+// ZoneSettingChange is a difference in a setting between two snapshots.
+// From is nil if the setting is not in the first snapshot.
+type ZoneSettingChange struct {
+	ID   string
+	From interface{}
+	To   interface{}
+}
+
+// String returns the change as `id: from -> to`, with the values as JSON.
+func (c ZoneSettingChange) String() string {
+	from, _ := json.Marshal(c.From)
+	to, _ := json.Marshal(c.To)
+	return fmt.Sprintf("%s: %s -> %s", c.ID, from, to)
+}
+
+// Diff returns the changes needed to make the settings of s match desired,
+// ordered by ID. Settings desired does not include are ignored.
+func (s ZoneSettingsSnapshot) Diff(desired ZoneSettingsSnapshot) []ZoneSettingChange {
+	current := s.values()
+	var changes []ZoneSettingChange
+	for _, setting := range desired.Settings() {
+		if from, ok := current[setting.ID]; !ok || !reflect.DeepEqual(from, setting.Value) {
+			changes = append(changes, ZoneSettingChange{ID: setting.ID, From: from, To: setting.Value})
+		}
+	}
+	return changes
+}
+
+// SnapshotZoneSettings returns a snapshot of all of a zone's settings.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
+func (api *API) SnapshotZoneSettings(zoneID string) (ZoneSettingsSnapshot, error) {
+	return api.SnapshotZoneSettingsContext(context.TODO(), zoneID)
+}
+
+// SnapshotZoneSettingsContext is like SnapshotZoneSettings but takes a
+// context.
+func (api *API) SnapshotZoneSettingsContext(ctx context.Context, zoneID string) (ZoneSettingsSnapshot, error) {
+	res, err := api.ZoneSettingsContext(ctx, zoneID)
+	if err != nil {
+		return ZoneSettingsSnapshot{}, err
+	}
+	return NewZoneSettingsSnapshot(res.Result)
+}
+
+// DiffZoneSettings returns the changes needed to make the settings of the
+// zone fromZoneID match those of toZoneID.
+func (api *API) DiffZoneSettings(fromZoneID, toZoneID string) ([]ZoneSettingChange, error) {
+	return api.DiffZoneSettingsContext(context.TODO(), fromZoneID, toZoneID)
+}
+
+// DiffZoneSettingsContext is like DiffZoneSettings but takes a context.
+func (api *API) DiffZoneSettingsContext(ctx context.Context, fromZoneID, toZoneID string) ([]ZoneSettingChange, error) {
+	from, err := api.SnapshotZoneSettingsContext(ctx, fromZoneID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.SnapshotZoneSettingsContext(ctx, toZoneID)
+	if err != nil {
+		return nil, err
+	}
+	return from.Diff(to), nil
+}
+
+// ApplyZoneSettings makes the settings of a zone match those included in
+// desired. Only the settings that differ are updated, in a single request,
+// and the changes made are returned. Nothing is changed if any of them is
+// not editable on the zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
+func (api *API) ApplyZoneSettings(zoneID string, desired ZoneSettingsSnapshot) ([]ZoneSettingChange, error) {
+	return api.ApplyZoneSettingsContext(context.TODO(), zoneID, desired)
+}
+
+// ApplyZoneSettingsContext is like ApplyZoneSettings but takes a context.
+func (api *API) ApplyZoneSettingsContext(ctx context.Context, zoneID string, desired ZoneSettingsSnapshot) ([]ZoneSettingChange, error) {
+	res, err := api.ZoneSettingsContext(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := NewZoneSettingsSnapshot(res.Result)
+	if err != nil {
+		return nil, err
+	}
+	changes := current.Diff(desired)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	editable := make(map[string]bool, len(res.Result))
+	for _, setting := range res.Result {
+		editable[setting.ID] = setting.Editable
+	}
+	items := make([]ZoneSetting, 0, len(changes))
+	for _, c := range changes {
+		if e, ok := editable[c.ID]; ok && !e {
+			return nil, errors.Errorf("zone setting %s is not editable", c.ID)
+		}
+		items = append(items, ZoneSetting{ID: c.ID, Value: c.To})
+	}
+	if _, err := api.UpdateZoneSettingsContext(ctx, zoneID, items); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
@@ -0,0 +1,178 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const zoneSettingsJSON = `{
+	"success": true, "errors": [], "messages": [],
+	"result": [
+		{"id": "always_online", "value": "on", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"},
+		{"id": "browser_cache_ttl", "value": 0, "editable": true},
+		{"id": "ciphers", "value": ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"], "editable": true},
+		{"id": "min_tls_version", "value": "1.0", "editable": true},
+		{"id": "minify", "value": {"css": "on", "html": "off", "js": "off"}, "editable": true},
+		{"id": "security_header", "value": {"strict_transport_security": {"enabled": true, "max_age": 86400, "include_subdomains": true, "preload": false, "nosniff": true}}, "editable": true},
+		{"id": "advanced_ddos", "value": "on", "editable": false},
+		{"id": "new_feature", "value": {"mode": "auto"}, "editable": true}
+	]
+}`
+
+func TestNewZoneSettingsSnapshot(t *testing.T) {
+	var r ZoneSettingResponse
+	if !assert.NoError(t, json.Unmarshal([]byte(zoneSettingsJSON), &r)) {
+		return
+	}
+	snapshot, err := NewZoneSettingsSnapshot(r.Result)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	zero := 0
+	assert.Equal(t, ZoneSettingsSnapshot{
+		AdvancedDDoS:    "on",
+		AlwaysOnline:    "on",
+		BrowserCacheTTL: &zero,
+		Ciphers:         []string{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"},
+		MinTLSVersion:   "1.0",
+		Minify:          &ZoneSettingMinify{CSS: "on", HTML: "off", JS: "off"},
+		SecurityHeader: &ZoneSettingSecurityHeader{StrictTransportSecurity: ZoneSettingHSTS{
+			Enabled: true, MaxAge: 86400, IncludeSubdomains: true, NoSniff: true,
+		}},
+		Other: map[string]interface{}{"new_feature": map[string]interface{}{"mode": "auto"}},
+	}, snapshot)
+
+	settings := snapshot.Settings()
+	if assert.Len(t, settings, 8) {
+		assert.Equal(t, "advanced_ddos", settings[0].ID)
+		assert.Equal(t, "new_feature", settings[6].ID)
+		assert.Equal(t, float64(0), settings[2].Value)
+	}
+
+	_, err = NewZoneSettingsSnapshot([]ZoneSetting{{ID: "minify", Value: "on"}})
+	assert.Error(t, err)
+}
+
+func TestZoneSettingsSnapshot_Serialization(t *testing.T) {
+	ttl := 14400
+	snapshot := ZoneSettingsSnapshot{
+		BrowserCacheTTL: &ttl,
+		MinTLSVersion:   "1.2",
+		Minify:          &ZoneSettingMinify{CSS: "on", HTML: "on", JS: "off"},
+		Other:           map[string]interface{}{"new_feature": map[string]interface{}{"mode": "auto"}},
+	}
+
+	b, err := json.Marshal(snapshot)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"browser_cache_ttl": 14400,
+			"min_tls_version": "1.2",
+			"minify": {"css": "on", "html": "on", "js": "off"},
+			"other": {"new_feature": {"mode": "auto"}}
+		}`, string(b))
+	}
+
+	b, err = yaml.Marshal(snapshot)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `browser_cache_ttl: 14400
+min_tls_version: "1.2"
+minify:
+  css: "on"
+  html: "on"
+  js: "off"
+other:
+  new_feature:
+    mode: auto
+`, string(b))
+
+	var decoded ZoneSettingsSnapshot
+	if assert.NoError(t, yaml.Unmarshal(b, &decoded)) {
+		assert.Empty(t, decoded.Diff(snapshot))
+		assert.Empty(t, snapshot.Diff(decoded))
+	}
+}
+
+func TestZoneSettingsSnapshot_Diff(t *testing.T) {
+	zero, ttl := 0, 14400
+	current := ZoneSettingsSnapshot{
+		AlwaysOnline:    "on",
+		BrowserCacheTTL: &zero,
+		MinTLSVersion:   "1.0",
+		Minify:          &ZoneSettingMinify{CSS: "on", HTML: "off", JS: "off"},
+	}
+	desired := ZoneSettingsSnapshot{
+		BrowserCacheTTL: &ttl,
+		MinTLSVersion:   "1.2",
+		Minify:          &ZoneSettingMinify{CSS: "on", HTML: "off", JS: "off"},
+		SSL:             "strict",
+	}
+
+	var lines []string
+	for _, c := range current.Diff(desired) {
+		lines = append(lines, c.String())
+	}
+	assert.Equal(t, []string{
+		`browser_cache_ttl: 0 -> 14400`,
+		`min_tls_version: "1.0" -> "1.2"`,
+		`ssl: null -> "strict"`,
+	}, lines)
+}
+
+func TestApplyZoneSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var patched []map[string]interface{}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, zoneSettingsJSON)
+		case "PATCH":
+			var body struct {
+				Items []map[string]interface{} `json:"items"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			patched = body.Items
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	baseline := ZoneSettingsSnapshot{
+		AlwaysOnline:  "on",
+		MinTLSVersion: "1.2",
+		SecurityHeader: &ZoneSettingSecurityHeader{StrictTransportSecurity: ZoneSettingHSTS{
+			Enabled: true, MaxAge: 31536000, IncludeSubdomains: true, NoSniff: true,
+		}},
+	}
+	changes, err := client.ApplyZoneSettings("023e105f4ecef8ad9ca31a8372d0c353", baseline)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, changes, 2)
+	if assert.Len(t, patched, 2) {
+		assert.Equal(t, "min_tls_version", patched[0]["id"])
+		assert.Equal(t, "1.2", patched[0]["value"])
+		assert.Equal(t, "security_header", patched[1]["id"])
+	}
+
+	patched = nil
+	changes, err = client.ApplyZoneSettings("023e105f4ecef8ad9ca31a8372d0c353", ZoneSettingsSnapshot{AlwaysOnline: "on"})
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Nil(t, patched, "unchanged settings must not be updated")
+
+	_, err = client.ApplyZoneSettings("023e105f4ecef8ad9ca31a8372d0c353", ZoneSettingsSnapshot{AdvancedDDoS: "off"})
+	assert.EqualError(t, err, "zone setting advanced_ddos is not editable")
+	assert.Nil(t, patched)
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -726,6 +729,123 @@ var expectedPartialZoneSetup = Zone{
 	NameServers: []string{"tony.ns.cloudflare.com", "woz.ns.cloudflare.com"},
 }
 
+// zonePagesHandler serves totalZones zones named zone-N.example.com, in
+// pages of per_page, and records how often each page was requested.
+func zonePagesHandler(t *testing.T, totalZones int, failPage int) (http.HandlerFunc, func() map[int]int) {
+	var mu sync.Mutex
+	requests := make(map[int]int)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		assert.Equal(t, 50, perPage)
+
+		mu.Lock()
+		requests[page]++
+		mu.Unlock()
+
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var zones []string
+		for i := (page-1)*perPage + 1; i <= totalZones && len(zones) < perPage; i++ {
+			zones = append(zones, fmt.Sprintf(`{"id": "%d", "name": "zone-%d.example.com"}`, i, i))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {
+				"page": %d,
+				"per_page": %d,
+				"count": %d,
+				"total_count": %d,
+				"total_pages": %d
+			}
+		}`, strings.Join(zones, ","), page, perPage, len(zones), totalZones, (totalZones+perPage-1)/perPage)
+	}
+
+	return handler, func() map[int]int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestListZones_AllPagesInOrder(t *testing.T) {
+	setup(UsingPaginationConcurrency(3))
+	defer teardown()
+
+	handler, requests := zonePagesHandler(t, 1234, 0)
+	mux.HandleFunc("/zones", handler)
+
+	zones, err := client.ListZones()
+	if assert.NoError(t, err) && assert.Len(t, zones, 1234) {
+		for i, zone := range zones {
+			assert.Equal(t, strconv.Itoa(i+1), zone.ID)
+		}
+	}
+
+	// every page is requested exactly once
+	reqs := requests()
+	assert.Len(t, reqs, 25)
+	for page, n := range reqs {
+		assert.Equal(t, 1, n, "page %d requested %d times", page, n)
+	}
+}
+
+func TestListZones_PageError(t *testing.T) {
+	setup(UsingPaginationConcurrency(4))
+	defer teardown()
+
+	handler, _ := zonePagesHandler(t, 1000, 7)
+	mux.HandleFunc("/zones", handler)
+
+	zones, err := client.ListZones()
+	assert.Error(t, err)
+	assert.Empty(t, zones)
+
+	if apiErr, ok := apiErrorFrom(err); assert.True(t, ok) {
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	}
+}
+
+func TestListZones_ByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		name := r.URL.Query().Get("name")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "id-%[1]s", "name": "%[1]s"}],
+			"result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+		}`, name)
+	}
+	mux.HandleFunc("/zones", handler)
+
+	zones, err := client.ListZones("example.org", "example.net")
+	if assert.NoError(t, err) && assert.Len(t, zones, 2) {
+		assert.Equal(t, "example.org", zones[0].Name)
+		assert.Equal(t, "example.net", zones[1].Name)
+	}
+}
+
+func TestUsingPaginationConcurrency_Invalid(t *testing.T) {
+	_, err := New("deadbeef", "cloudflare@example.org", UsingPaginationConcurrency(0))
+	assert.Error(t, err)
+}
+
 func TestCreateZoneFullSetup(t *testing.T) {
 	setup()
 	defer teardown()